@@ -0,0 +1,331 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Adaptive client-side rate limiting.
+// A rate limiter is shared by all AWS API clients for the same service and AWS Region.
+// Rate limiting starts when the service first throttles a request. The allowed request rate is then
+// adjusted using AIMD (additive increase, multiplicative decrease): each throttled response reduces
+// the rate by a constant factor and each successful response increases it by a constant amount.
+// Rate limiting stops once the rate recovers to its maximum.
+
+const (
+	adaptiveRateLimitHandlerName = "TFAWSAdaptiveRateLimit"
+)
+
+const (
+	defaultAdaptiveRateLimitMaxRate = 20  // Maximum rate, in requests per second, used if not configured.
+	adaptiveRateLimitDecreaseFactor = 0.7 // Multiplicative decrease on a throttled response.
+	adaptiveRateLimitIncrease       = 0.1 // Additive increase, in requests per second, on a successful response.
+	adaptiveRateLimitMinRate        = 0.5 // Minimum rate, in requests per second.
+)
+
+// adaptiveRateLimitState is a snapshot of an adaptive rate limiter's state.
+type adaptiveRateLimitState struct {
+	Enabled bool    `json:"enabled"`
+	Rate    float64 `json:"rate,omitempty"` // Requests per second.
+	Tokens  float64 `json:"tokens,omitempty"`
+}
+
+// adaptiveRateLimiter is a token bucket whose fill rate is adjusted using AIMD.
+// The bucket holds at most one second's worth of requests at the maximum rate.
+type adaptiveRateLimiter struct {
+	capacity   float64
+	enabled    bool
+	lastRefill time.Time
+	maxRate    float64
+	mu         sync.Mutex
+	now        func() time.Time
+	rate       float64
+	tokens     float64
+}
+
+func newAdaptiveRateLimiter(maxRate int, now func() time.Time) *adaptiveRateLimiter {
+	if maxRate <= 0 {
+		maxRate = defaultAdaptiveRateLimitMaxRate
+	}
+
+	return &adaptiveRateLimiter{
+		capacity: float64(maxRate),
+		maxRate:  float64(maxRate),
+		now:      now,
+		rate:     float64(maxRate),
+	}
+}
+
+// refill adds tokens for the time elapsed since the last refill.
+// The lock must be held.
+func (l *adaptiveRateLimiter) refill() {
+	now := l.now()
+	if !l.lastRefill.IsZero() {
+		l.tokens = math.Min(l.capacity, l.tokens+now.Sub(l.lastRefill).Seconds()*l.rate)
+	}
+	l.lastRefill = now
+}
+
+// reserve takes a token, returning how long the caller must wait before sending a request.
+func (l *adaptiveRateLimiter) reserve() (time.Duration, adaptiveRateLimitState) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.enabled {
+		return 0, l.stateLocked()
+	}
+
+	l.refill()
+	l.tokens--
+
+	var delay time.Duration
+	if l.tokens < 0 {
+		delay = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+
+	return delay, l.stateLocked()
+}
+
+// wait blocks until a request can be sent or the Context is done.
+func (l *adaptiveRateLimiter) wait(ctx context.Context) (adaptiveRateLimitState, error) {
+	delay, state := l.reserve()
+
+	if delay == 0 {
+		return state, nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return state, nil
+	case <-ctx.Done():
+		// Return the unused token.
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+
+		return state, ctx.Err()
+	}
+}
+
+// onThrottle reduces the rate, enabling rate limiting if it is not already enabled.
+func (l *adaptiveRateLimiter) onThrottle() adaptiveRateLimitState {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.enabled {
+		l.enabled = true
+		l.tokens = 0
+		l.lastRefill = l.now()
+	} else {
+		l.refill()
+	}
+	l.rate = math.Max(adaptiveRateLimitMinRate, l.rate*adaptiveRateLimitDecreaseFactor)
+
+	return l.stateLocked()
+}
+
+// onSuccess increases the rate, disabling rate limiting once the maximum rate is reached.
+// Returns whether rate limiting was disabled.
+func (l *adaptiveRateLimiter) onSuccess() (adaptiveRateLimitState, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.enabled {
+		return l.stateLocked(), false
+	}
+
+	l.refill()
+	l.rate += adaptiveRateLimitIncrease
+
+	if l.rate >= l.maxRate {
+		l.enabled = false
+		l.rate = l.maxRate
+		return l.stateLocked(), true
+	}
+
+	return l.stateLocked(), false
+}
+
+func (l *adaptiveRateLimiter) stateLocked() adaptiveRateLimitState {
+	if !l.enabled {
+		return adaptiveRateLimitState{}
+	}
+
+	return adaptiveRateLimitState{
+		Enabled: true,
+		Rate:    l.rate,
+		Tokens:  l.tokens,
+	}
+}
+
+// adaptiveRateLimiterKey is the key for adaptive rate limiters.
+type adaptiveRateLimiterKey struct {
+	region    string
+	serviceID string
+}
+
+// adaptiveRateLimiters is the set of adaptive rate limiters for a provider configuration.
+type adaptiveRateLimiters struct {
+	limiters map[adaptiveRateLimiterKey]*adaptiveRateLimiter
+	maxRate  int
+	mu       sync.Mutex
+	now      func() time.Time
+}
+
+func newAdaptiveRateLimiters(maxRate int) *adaptiveRateLimiters {
+	return &adaptiveRateLimiters{
+		limiters: make(map[adaptiveRateLimiterKey]*adaptiveRateLimiter),
+		maxRate:  maxRate,
+		now:      time.Now,
+	}
+}
+
+// get returns the adaptive rate limiter for the specified service and AWS Region.
+func (l *adaptiveRateLimiters) get(serviceID, region string) *adaptiveRateLimiter {
+	key := adaptiveRateLimiterKey{
+		region:    region,
+		serviceID: normalizeServiceID(serviceID),
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	v, ok := l.limiters[key]
+	if !ok {
+		v = newAdaptiveRateLimiter(l.maxRate, l.now)
+		l.limiters[key] = v
+	}
+
+	return v
+}
+
+// before waits until a request can be sent, returning a Context containing the rate limiter's state.
+func (l *adaptiveRateLimiters) before(ctx context.Context, serviceID, region string) (context.Context, error) {
+	state, err := l.get(serviceID, region).wait(ctx)
+	if state.Enabled {
+		tflog.Debug(ctx, "Adaptive rate limit applied", map[string]any{
+			"tf_aws.adaptive_rate_limit.rate":   state.Rate,
+			"tf_aws.adaptive_rate_limit.tokens": state.Tokens,
+			"tf_aws.region":                     region,
+			"tf_aws.service_id":                 serviceID,
+		})
+	}
+
+	return withAdaptiveRateLimitState(ctx, state), err
+}
+
+// after adjusts the rate limiter for the response to a request.
+func (l *adaptiveRateLimiters) after(ctx context.Context, serviceID, region string, isThrottle, isSuccess bool) {
+	limiter := l.get(serviceID, region)
+
+	switch {
+	case isThrottle:
+		state := limiter.onThrottle()
+		tflog.Debug(ctx, "Adaptive rate limit decreased", map[string]any{
+			"tf_aws.adaptive_rate_limit.rate": state.Rate,
+			"tf_aws.region":                   region,
+			"tf_aws.service_id":               serviceID,
+		})
+	case isSuccess:
+		if _, disabled := limiter.onSuccess(); disabled {
+			tflog.Debug(ctx, "Adaptive rate limit disabled", map[string]any{
+				"tf_aws.region":     region,
+				"tf_aws.service_id": serviceID,
+			})
+		}
+	}
+}
+
+// signHandlerV1 returns an AWS SDK for Go v1 request handler that waits until each attempt can be sent.
+func (l *adaptiveRateLimiters) signHandlerV1() request_sdkv1.NamedHandler {
+	return request_sdkv1.NamedHandler{
+		Name: adaptiveRateLimitHandlerName,
+		Fn: func(r *request_sdkv1.Request) {
+			ctx, err := l.before(r.Context(), r.ClientInfo.ServiceID, aws_sdkv1.StringValue(r.Config.Region))
+			if err != nil {
+				r.Error = awserr.New(request_sdkv1.CanceledErrorCode, "request context canceled", err)
+				r.Retryable = aws_sdkv1.Bool(false)
+				return
+			}
+			r.HTTPRequest = r.HTTPRequest.WithContext(ctx)
+		},
+	}
+}
+
+// retryHandlerV1 returns an AWS SDK for Go v1 request handler that adjusts the rate limiter for throttled attempts.
+func (l *adaptiveRateLimiters) retryHandlerV1() request_sdkv1.NamedHandler {
+	return request_sdkv1.NamedHandler{
+		Name: adaptiveRateLimitHandlerName,
+		Fn: func(r *request_sdkv1.Request) {
+			if request_sdkv1.IsErrorThrottle(r.Error) {
+				l.after(r.Context(), r.ClientInfo.ServiceID, aws_sdkv1.StringValue(r.Config.Region), true, false)
+			}
+		},
+	}
+}
+
+// completeHandlerV1 returns an AWS SDK for Go v1 request handler that adjusts the rate limiter for successful requests.
+func (l *adaptiveRateLimiters) completeHandlerV1() request_sdkv1.NamedHandler {
+	return request_sdkv1.NamedHandler{
+		Name: adaptiveRateLimitHandlerName,
+		Fn: func(r *request_sdkv1.Request) {
+			if r.Error == nil {
+				l.after(r.Context(), r.ClientInfo.ServiceID, aws_sdkv1.StringValue(r.Config.Region), false, true)
+			}
+		},
+	}
+}
+
+// addMiddlewareV2 adds AWS SDK for Go v2 middleware that waits until each attempt can be sent and adjusts the rate limiter for the response.
+func (l *adaptiveRateLimiters) addMiddlewareV2(stack *middleware.Stack) error {
+	throttles := retry_sdkv2.IsErrorThrottles(retry_sdkv2.DefaultThrottles)
+
+	// Runs once per attempt.
+	attempt := middleware.FinalizeMiddlewareFunc(adaptiveRateLimitHandlerName, func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		serviceID, region := awsmiddleware.GetServiceID(ctx), awsmiddleware.GetRegion(ctx)
+
+		ctx, err := l.before(ctx, serviceID, region)
+		if err != nil {
+			return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+		}
+
+		out, metadata, err := next.HandleFinalize(ctx, in)
+
+		l.after(ctx, serviceID, region, err != nil && throttles.IsErrorThrottle(err).Bool(), err == nil)
+
+		return out, metadata, err
+	})
+
+	if _, ok := stack.Finalize.Get("Retry"); ok {
+		return stack.Finalize.Insert(attempt, "Retry", middleware.After)
+	}
+
+	return stack.Finalize.Add(attempt, middleware.After)
+}
+
+type adaptiveRateLimitStateKey struct{}
+
+func withAdaptiveRateLimitState(ctx context.Context, v adaptiveRateLimitState) context.Context {
+	return context.WithValue(ctx, adaptiveRateLimitStateKey{}, v)
+}
+
+func adaptiveRateLimitStateFromContext(ctx context.Context) (adaptiveRateLimitState, bool) {
+	v, ok := ctx.Value(adaptiveRateLimitStateKey{}).(adaptiveRateLimitState)
+	return v, ok
+}
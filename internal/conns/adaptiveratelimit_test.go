@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestAdaptiveRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := newAdaptiveRateLimiter(10, func() time.Time { return now })
+
+	// Not enabled until the first throttle.
+	if delay, state := limiter.reserve(); delay != 0 || state.Enabled {
+		t.Fatalf("reserve before throttle: delay = %s, state = %+v", delay, state)
+	}
+
+	state := limiter.onThrottle()
+	if !state.Enabled {
+		t.Fatal("expected rate limiting to be enabled after throttle")
+	}
+	if got, want := state.Rate, 7.0; got != want {
+		t.Errorf("rate after throttle: got %v, want %v", got, want)
+	}
+
+	state = limiter.onThrottle()
+	if got, want := state.Rate, 4.9; !approximatelyEqual(got, want) {
+		t.Errorf("rate after second throttle: got %v, want %v", got, want)
+	}
+
+	// The bucket is empty after enabling, so the first request waits for one token.
+	delay, _ := limiter.reserve()
+	if got, want := delay, time.Duration(float64(time.Second)/state.Rate); got != want {
+		t.Errorf("first delay: got %s, want %s", got, want)
+	}
+
+	// Refill.
+	now = now.Add(10 * time.Second)
+	if delay, _ := limiter.reserve(); delay != 0 {
+		t.Errorf("delay after refill: got %s, want 0", delay)
+	}
+
+	// Additive increase until the maximum rate disables rate limiting.
+	var disabled bool
+	for i := 0; i < 100 && !disabled; i++ {
+		_, disabled = limiter.onSuccess()
+	}
+	if !disabled {
+		t.Fatal("expected rate limiting to be disabled after recovery")
+	}
+	if delay, state := limiter.reserve(); delay != 0 || state.Enabled {
+		t.Errorf("reserve after recovery: delay = %s, state = %+v", delay, state)
+	}
+}
+
+func TestAdaptiveRateLimiterMinRate(t *testing.T) {
+	t.Parallel()
+
+	limiter := newAdaptiveRateLimiter(0, time.Now)
+
+	var state adaptiveRateLimitState
+	for i := 0; i < 100; i++ {
+		state = limiter.onThrottle()
+	}
+
+	if got, want := state.Rate, adaptiveRateLimitMinRate; got != want {
+		t.Errorf("rate: got %v, want %v", got, want)
+	}
+}
+
+func TestAdaptiveRateLimiterWaitCanceled(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	limiter := newAdaptiveRateLimiter(10, func() time.Time { return now })
+	for i := 0; i < 100; i++ {
+		limiter.onThrottle()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := limiter.wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	// The token is returned.
+	if got, want := limiter.tokens, 0.0; got != want {
+		t.Errorf("tokens: got %v, want %v", got, want)
+	}
+}
+
+func TestAdaptiveRateLimitersShared(t *testing.T) {
+	t.Parallel()
+
+	limiters := newAdaptiveRateLimiters(10)
+
+	//lintignore:AWSAT003
+	if limiters.get("Route 53", "us-east-1") != limiters.get("route53", "us-east-1") {
+		t.Error("expected the same rate limiter for the same service and Region")
+	}
+
+	//lintignore:AWSAT003
+	if limiters.get("Route 53", "us-east-1") == limiters.get("Route 53", "us-west-2") {
+		t.Error("expected different rate limiters for different Regions")
+	}
+}
+
+func approximatelyEqual(a, b float64) bool {
+	const epsilon = 1e-9
+	return a-b < epsilon && b-a < epsilon
+}
@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+)
+
+const (
+	// APICallLogPathEnvVar is the environment variable that sets the API call log file path.
+	APICallLogPathEnvVar = "TF_AWS_API_CALL_LOG_PATH"
+)
+
+const (
+	apiCallLogHandlerName = "TFAWSAPICallLog"
+)
+
+// apiCallLogEntry is a single line in the API call log.
+type apiCallLogEntry struct {
+	Time         time.Time `json:"time"`
+	Service      string    `json:"service,omitempty"`
+	Operation    string    `json:"operation,omitempty"`
+	Region       string    `json:"region,omitempty"`
+	RequestID    string    `json:"request_id,omitempty"`
+	HTTPStatus   int       `json:"http_status,omitempty"`
+	Attempt      int       `json:"attempt,omitempty"`
+	LatencyMS    int64     `json:"latency_ms"`
+	Error        string    `json:"error,omitempty"`
+	ResourceType string    `json:"tf_resource_type,omitempty"`
+	ResourceName string    `json:"tf_resource_name,omitempty"`
+	IsDataSource bool      `json:"tf_data_source,omitempty"`
+
+	AdaptiveRateLimit *adaptiveRateLimitState `json:"adaptive_rate_limit,omitempty"` // Only if adaptive rate limiting is enabled.
+}
+
+// apiCallInfo is the AWS API call information that is passed to the HTTP client in Context.
+type apiCallInfo struct {
+	attempt   int
+	operation string
+	region    string
+	service   string
+}
+
+type apiCallInfoKey struct{}
+
+func withAPICallInfo(ctx context.Context, v *apiCallInfo) context.Context {
+	return context.WithValue(ctx, apiCallInfoKey{}, v)
+}
+
+func apiCallInfoFromContext(ctx context.Context) (*apiCallInfo, bool) {
+	v, ok := ctx.Value(apiCallInfoKey{}).(*apiCallInfo)
+	return v, ok
+}
+
+// apiCallLogger writes one JSON line per AWS API request attempt.
+type apiCallLogger struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+var (
+	apiCallLoggersMu sync.Mutex
+	apiCallLoggers   = make(map[string]*apiCallLogger) // Shared by all provider configurations, keyed by file path.
+)
+
+// openAPICallLogger returns the API call logger that appends to the specified file.
+func openAPICallLogger(path string) (*apiCallLogger, error) {
+	apiCallLoggersMu.Lock()
+	defer apiCallLoggersMu.Unlock()
+
+	if v, ok := apiCallLoggers[path]; ok {
+		return v, nil
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	v := newAPICallLogger(f)
+	apiCallLoggers[path] = v
+
+	return v, nil
+}
+
+func newAPICallLogger(w io.Writer) *apiCallLogger {
+	return &apiCallLogger{
+		w: w,
+	}
+}
+
+func (l *apiCallLogger) write(entry *apiCallLogEntry) {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	b = append(b, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// Logging is best effort.
+	if _, err := l.w.Write(b); err != nil {
+		return
+	}
+}
+
+// do sends the HTTP request using next and logs the result.
+func (l *apiCallLogger) do(request *http.Request, next func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	start := time.Now()
+	response, err := next(request)
+
+	ctx := request.Context()
+	entry := &apiCallLogEntry{
+		Time:      start.UTC(),
+		LatencyMS: time.Since(start).Milliseconds(),
+	}
+	if v, ok := apiCallInfoFromContext(ctx); ok {
+		entry.Attempt = v.attempt
+		entry.Operation = v.operation
+		entry.Region = v.region
+		entry.Service = v.service
+	}
+	if v, ok := adaptiveRateLimitStateFromContext(ctx); ok {
+		entry.AdaptiveRateLimit = &v
+	}
+	if v, ok := FromContext(ctx); ok {
+		entry.IsDataSource = v.IsDataSource
+		entry.ResourceName = v.ResourceName
+		entry.ResourceType = v.TypeName
+	}
+	if response != nil {
+		entry.HTTPStatus = response.StatusCode
+		entry.RequestID = requestIDFromHeader(response.Header)
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	l.write(entry)
+
+	return response, err
+}
+
+// wrapHTTPClientV1 returns a copy of the AWS SDK for Go v1 HTTP client that logs all requests.
+func (l *apiCallLogger) wrapHTTPClientV1(httpClient *http.Client) *http.Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	next := httpClient.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	v := *httpClient
+	v.Transport = &apiCallLogTransport{
+		logger: l,
+		next:   next,
+	}
+
+	return &v
+}
+
+// wrapHTTPClientV2 returns an AWS SDK for Go v2 HTTP client that logs all requests.
+func (l *apiCallLogger) wrapHTTPClientV2(httpClient aws_sdkv2.HTTPClient) aws_sdkv2.HTTPClient {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return &apiCallLogHTTPClient{
+		logger: l,
+		next:   httpClient,
+	}
+}
+
+// handlerV1 returns an AWS SDK for Go v1 request handler that passes API call information to the HTTP client.
+func (l *apiCallLogger) handlerV1() request_sdkv1.NamedHandler {
+	return request_sdkv1.NamedHandler{
+		Name: apiCallLogHandlerName,
+		Fn: func(r *request_sdkv1.Request) {
+			r.HTTPRequest = r.HTTPRequest.WithContext(withAPICallInfo(r.HTTPRequest.Context(), &apiCallInfo{
+				attempt:   r.RetryCount + 1,
+				operation: r.Operation.Name,
+				region:    aws_sdkv1.StringValue(r.Config.Region),
+				service:   r.ClientInfo.ServiceID,
+			}))
+		},
+	}
+}
+
+// addMiddlewareV2 adds AWS SDK for Go v2 middleware that passes API call information to the HTTP client.
+func (l *apiCallLogger) addMiddlewareV2(stack *middleware.Stack) error {
+	// Runs once per API call, after the service metadata is registered.
+	if err := stack.Initialize.Add(middleware.InitializeMiddlewareFunc(apiCallLogHandlerName, func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		ctx = withAPICallInfo(ctx, &apiCallInfo{
+			operation: awsmiddleware.GetOperationName(ctx),
+			region:    awsmiddleware.GetRegion(ctx),
+			service:   awsmiddleware.GetServiceID(ctx),
+		})
+
+		return next.HandleInitialize(ctx, in)
+	}), middleware.After); err != nil {
+		return err
+	}
+
+	// Runs once per attempt.
+	attempt := middleware.FinalizeMiddlewareFunc(apiCallLogHandlerName, func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		if v, ok := apiCallInfoFromContext(ctx); ok {
+			v.attempt++
+			info := *v
+			ctx = withAPICallInfo(ctx, &info)
+		}
+
+		return next.HandleFinalize(ctx, in)
+	})
+
+	if _, ok := stack.Finalize.Get("Retry"); ok {
+		return stack.Finalize.Insert(attempt, "Retry", middleware.After)
+	}
+
+	return stack.Finalize.Add(attempt, middleware.After)
+}
+
+type apiCallLogTransport struct {
+	logger *apiCallLogger
+	next   http.RoundTripper
+}
+
+func (t *apiCallLogTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	return t.logger.do(request, t.next.RoundTrip)
+}
+
+type apiCallLogHTTPClient struct {
+	logger *apiCallLogger
+	next   aws_sdkv2.HTTPClient
+}
+
+func (c *apiCallLogHTTPClient) Do(request *http.Request) (*http.Response, error) {
+	return c.logger.do(request, c.next.Do)
+}
+
+// requestIDFromHeader returns the AWS request ID from HTTP response headers.
+func requestIDFromHeader(header http.Header) string {
+	for _, k := range []string{"X-Amzn-Requestid", "X-Amz-Request-Id", "X-Amzn-Request-Id"} {
+		if v := header.Get(k); v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestAPICallLoggerDo(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	logger := newAPICallLogger(&buf)
+
+	ctx := NewResourceContext(context.TODO(), "ec2", "Subnet", "aws_subnet")
+	ctx = withAPICallInfo(ctx, &apiCallInfo{
+		attempt:   2,
+		operation: "CreateSubnet",
+		region:    "us-west-2", //lintignore:AWSAT003
+		service:   "EC2",
+	})
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://ec2.us-west-2.amazonaws.com/", nil) //lintignore:AWSAT003
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = logger.do(request, func(*http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusBadRequest,
+			Header: http.Header{
+				"X-Amzn-Requestid": []string{"3ee4e4e7-0a6a-4b86-b4e5-b6d5f2a8c0f1"},
+			},
+		}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = logger.do(request.WithContext(context.TODO()), func(*http.Request) (*http.Response, error) {
+		return nil, errors.New("connection reset")
+	})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	var got []apiCallLogEntry
+	decoder := json.NewDecoder(&buf)
+	for decoder.More() {
+		var entry apiCallLogEntry
+		if err := decoder.Decode(&entry); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, entry)
+	}
+
+	want := []apiCallLogEntry{
+		{
+			Service:      "EC2",
+			Operation:    "CreateSubnet",
+			Region:       "us-west-2", //lintignore:AWSAT003
+			RequestID:    "3ee4e4e7-0a6a-4b86-b4e5-b6d5f2a8c0f1",
+			HTTPStatus:   http.StatusBadRequest,
+			Attempt:      2,
+			ResourceType: "aws_subnet",
+			ResourceName: "Subnet",
+		},
+		{
+			Error: "connection reset",
+		},
+	}
+
+	if diff := cmp.Diff(got, want, cmpopts.IgnoreFields(apiCallLogEntry{}, "Time", "LatencyMS")); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
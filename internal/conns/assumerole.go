@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	stscreds_sdkv2 "github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+)
+
+// assumeRoleHop is one IAM Role assumption in a chain of `assume_role` blocks.
+type assumeRoleHop struct {
+	index    int
+	count    int
+	roleARN  string
+	provider aws_sdkv2.CredentialsProvider
+}
+
+var _ aws_sdkv2.CredentialsProvider = (*assumeRoleHop)(nil)
+
+func (h *assumeRoleHop) Retrieve(ctx context.Context) (aws_sdkv2.Credentials, error) {
+	v, err := h.provider.Retrieve(ctx)
+	if err != nil {
+		return v, fmt.Errorf("%s: %w", h, err)
+	}
+
+	return v, nil
+}
+
+func (h *assumeRoleHop) String() string {
+	return fmt.Sprintf("assume_role %d of %d (%s)", h.index+1, h.count, h.roleARN)
+}
+
+// assumeRoleChain returns cached credentials for each of the specified IAM Roles, in order.
+// The credentials in cfg must already assume the first IAM Role.
+// Each subsequent IAM Role is assumed using the credentials of the previous one.
+func assumeRoleChain(cfg aws_sdkv2.Config, roles []*awsbase.AssumeRole, stsEndpoint, stsRegion string) []*aws_sdkv2.CredentialsCache {
+	chain := make([]*aws_sdkv2.CredentialsCache, 0, len(roles))
+	credentials := cfg.Credentials
+
+	for i, role := range roles {
+		if i > 0 {
+			cfg := cfg.Copy()
+			cfg.Credentials = credentials
+			client := sts_sdkv2.NewFromConfig(cfg, func(o *sts_sdkv2.Options) {
+				if stsEndpoint != "" {
+					o.BaseEndpoint = aws_sdkv2.String(stsEndpoint)
+				}
+				if stsRegion != "" {
+					o.Region = stsRegion
+				}
+			})
+			credentials = stscreds_sdkv2.NewAssumeRoleProvider(client, role.RoleARN, assumeRoleOptions(role))
+		}
+
+		cache := aws_sdkv2.NewCredentialsCache(&assumeRoleHop{
+			index:    i,
+			count:    len(roles),
+			roleARN:  role.RoleARN,
+			provider: credentials,
+		})
+		chain = append(chain, cache)
+		credentials = cache
+	}
+
+	return chain
+}
+
+func assumeRoleOptions(role *awsbase.AssumeRole) func(*stscreds_sdkv2.AssumeRoleOptions) {
+	return func(o *stscreds_sdkv2.AssumeRoleOptions) {
+		o.Duration = role.Duration
+		if role.ExternalID != "" {
+			o.ExternalID = aws_sdkv2.String(role.ExternalID)
+		}
+		if role.Policy != "" {
+			o.Policy = aws_sdkv2.String(role.Policy)
+		}
+		for _, v := range role.PolicyARNs {
+			o.PolicyARNs = append(o.PolicyARNs, ststypes_sdkv2.PolicyDescriptorType{
+				Arn: aws_sdkv2.String(v),
+			})
+		}
+		o.RoleSessionName = role.SessionName
+		if role.SourceIdentity != "" {
+			o.SourceIdentity = aws_sdkv2.String(role.SourceIdentity)
+		}
+		keys := tfmaps.Keys(role.Tags)
+		slices.Sort(keys)
+		for _, k := range keys {
+			o.Tags = append(o.Tags, ststypes_sdkv2.Tag{
+				Key:   aws_sdkv2.String(k),
+				Value: aws_sdkv2.String(role.Tags[k]),
+			})
+		}
+		o.TransitiveTagKeys = role.TransitiveTagKeys
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+)
+
+func TestAssumeRoleChain(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var requests []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("parsing request: %s", err)
+		}
+		requests = append(requests, r.PostForm)
+
+		// Each hop is signed with the credentials returned by the previous hop.
+		hop := len(requests)
+		if want := fmt.Sprintf("Credential=AKID%d/", hop-1); !strings.Contains(r.Header.Get("Authorization"), want) {
+			t.Errorf("hop %d: expected Authorization header to contain %q, got %q", hop, want, r.Header.Get("Authorization"))
+		}
+
+		fmt.Fprintf(w, `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <Credentials>
+      <AccessKeyId>AKID%[1]d</AccessKeyId>
+      <SecretAccessKey>secret</SecretAccessKey>
+      <SessionToken>token</SessionToken>
+      <Expiration>2100-01-01T00:00:00Z</Expiration>
+    </Credentials>
+  </AssumeRoleResult>
+</AssumeRoleResponse>`, hop)
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := aws_sdkv2.Config{
+		Credentials: aws_sdkv2.CredentialsProviderFunc(func(context.Context) (aws_sdkv2.Credentials, error) {
+			return aws_sdkv2.Credentials{AccessKeyID: "AKID0", SecretAccessKey: "secret"}, nil
+		}),
+		Region: "us-west-2", //lintignore:AWSAT003
+	}
+	roles := []*awsbase.AssumeRole{
+		{
+			RoleARN: "arn:aws:iam::111111111111:role/sso", //lintignore:AWSAT005
+		},
+		{
+			ExternalID:  "external",
+			RoleARN:     "arn:aws:iam::222222222222:role/deployer", //lintignore:AWSAT005
+			SessionName: "deployer",
+			Tags: map[string]string{
+				"Team":    "platform",
+				"Purpose": "deploy",
+			},
+		},
+		{
+			RoleARN:     "arn:aws:iam::333333333333:role/workload", //lintignore:AWSAT005
+			SessionName: "workload",
+		},
+	}
+
+	chain := assumeRoleChain(cfg, roles, server.URL, "")
+
+	if got, want := len(chain), len(roles); got != want {
+		t.Fatalf("chain length: got %d, want %d", got, want)
+	}
+
+	credentials, err := chain[len(chain)-1].Retrieve(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := credentials.AccessKeyID, "AKID2"; got != want {
+		t.Errorf("AccessKeyID: got %q, want %q", got, want)
+	}
+
+	want := []map[string]string{
+		{
+			"ExternalId":          "external",
+			"RoleArn":             "arn:aws:iam::222222222222:role/deployer", //lintignore:AWSAT005
+			"RoleSessionName":     "deployer",
+			"Tags.member.1.Key":   "Purpose",
+			"Tags.member.1.Value": "deploy",
+			"Tags.member.2.Key":   "Team",
+			"Tags.member.2.Value": "platform",
+		},
+		{
+			"RoleArn":         "arn:aws:iam::333333333333:role/workload", //lintignore:AWSAT005
+			"RoleSessionName": "workload",
+			"ExternalId":      "",
+		},
+	}
+	if got, want := len(requests), len(want); got != want {
+		t.Fatalf("AssumeRole requests: got %d, want %d", got, want)
+	}
+	for i, request := range requests {
+		got := make(map[string]string, len(want[i]))
+		for k := range want[i] {
+			got[k] = request.Get(k)
+		}
+		if diff := cmp.Diff(got, want[i]); diff != "" {
+			t.Errorf("hop %d: unexpected diff (+wanted, -got): %s", i+2, diff)
+		}
+	}
+}
+
+func TestAssumeRoleChainError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	errFailed := errors.New("failed")
+
+	cfg := aws_sdkv2.Config{
+		Credentials: aws_sdkv2.CredentialsProviderFunc(func(context.Context) (aws_sdkv2.Credentials, error) {
+			return aws_sdkv2.Credentials{}, errFailed
+		}),
+		Region: "us-west-2", //lintignore:AWSAT003
+	}
+	roles := []*awsbase.AssumeRole{
+		{
+			RoleARN: "arn:aws:iam::111111111111:role/sso", //lintignore:AWSAT005
+		},
+		{
+			RoleARN: "arn:aws:iam::222222222222:role/deployer", //lintignore:AWSAT005
+		},
+	}
+
+	chain := assumeRoleChain(cfg, roles, "", "")
+
+	_, err := chain[0].Retrieve(ctx)
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("expected error %q, got %v", errFailed, err)
+	}
+	if got, want := err.Error(), "assume_role 1 of 2 (arn:aws:iam::111111111111:role/sso)"; !strings.Contains(got, want) { //lintignore:AWSAT005
+		t.Errorf("expected error to contain %q, got %q", want, got)
+	}
+}
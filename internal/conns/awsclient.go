@@ -19,35 +19,42 @@ import (
 	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	opsworks_sdkv1 "github.com/aws/aws-sdk-go/service/opsworks"
-	rds_sdkv1 "github.com/aws/aws-sdk-go/service/rds"
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type AWSClient struct {
-	AccountID         string
-	DefaultTagsConfig *tftags.DefaultConfig
-	IgnoreTagsConfig  *tftags.IgnoreConfig
-	Partition         string
-	Region            string
-	ServicePackages   map[string]ServicePackage
-
+	AccountID          string
+	DefaultTagsConfig  *tftags.DefaultConfig
+	IgnoreTagsConfig   *tftags.IgnoreConfig
+	Partition          string
+	Region             string
+	RequiredTagsConfig *tftags.RequiredConfig
+	ServicePackages    map[string]ServicePackage
+
+	allowedRegions            []string // From provider configuration.
 	awsConfig                 *aws_sdkv2.Config
-	clients                   map[string]any
-	conns                     map[string]any
+	baseEndpoint              baseEndpoint // From provider configuration.
+	clients                   map[clientCacheKey]any
+	conns                     map[clientCacheKey]any
 	dnsSuffix                 string
 	endpoints                 map[string]string // From provider configuration.
+	forbiddenRegions          []string          // From provider configuration.
 	httpClient                *http.Client
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
+	lookupCache               *lookupCache                 // From provider configuration.
+	retryPolicyConfigs        map[string]retryPolicyConfig // From provider configuration.
 	session                   *session_sdkv1.Session
-	s3ExpressClient           *s3_sdkv2.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
+	s3ExpressClients          map[string]*s3_sdkv2.Client
+	s3UsePathStyle            bool                        // From provider configuration.
+	s3USEast1RegionalEndpoint string                      // From provider configuration.
+	serviceSemaphores         map[string]tfsync.Semaphore // From provider configuration.
+	stsRegion                 string                      // From provider configuration.
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
@@ -62,14 +69,43 @@ func (c *AWSClient) AwsConfig(context.Context) aws_sdkv2.Config { // nosemgrep:c
 	return c.awsConfig.Copy()
 }
 
-// OpsWorksConnForRegion returns an AWS SDK For Go v1 OpsWorks API client for the specified AWS Region.
-// If the specified region is not the default a new "simple" client is created.
-// This new client does not use any configured endpoint override.
-func (c *AWSClient) OpsWorksConnForRegion(ctx context.Context, region string) *opsworks_sdkv1.OpsWorks {
-	if region == c.Region {
-		return c.OpsWorksConn(ctx)
+// EffectiveRegion returns the AWS Region that API calls made with the specified Context are sent to.
+// This is any per-resource Region override or else the provider's configured Region.
+func (c *AWSClient) EffectiveRegion(ctx context.Context) string {
+	if inContext, ok := FromContext(ctx); ok && inContext.OverrideRegion != "" {
+		return inContext.OverrideRegion
+	}
+
+	return c.Region
+}
+
+// ResourceDefaultTagsConfig returns the provider's default tags configuration that applies to the resource in the specified Context.
+// This is nil if the resource's type is excluded by the configuration.
+func (c *AWSClient) ResourceDefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig {
+	if inContext, ok := FromContext(ctx); ok && !inContext.IsDataSource {
+		return c.DefaultTagsConfig.ForResourceType(inContext.TypeName)
 	}
-	return opsworks_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
+
+	return c.DefaultTagsConfig
+}
+
+// VerifyRegionAllowed returns an error if the specified AWS Region is not allowed by the provider configuration.
+func (c *AWSClient) VerifyRegionAllowed(_ context.Context, region string) error {
+	return verifyRegionAllowed(region, c.allowedRegions, c.forbiddenRegions)
+}
+
+// ServiceSemaphore returns the semaphore that limits the number of in-flight CRUD operations for the specified service package.
+// The second return value is false if the service's concurrency is not limited.
+func (c *AWSClient) ServiceSemaphore(_ context.Context, servicePackageName string) (tfsync.Semaphore, bool) {
+	v, ok := c.serviceSemaphores[servicePackageName]
+	return v, ok
+}
+
+// OpsWorksConnForRegion returns an AWS SDK For Go v1 OpsWorks API client for the specified AWS Region.
+// Clients for Regions other than the default are created lazily and cached.
+// An error is returned if the Region is not allowed by the provider configuration.
+func (c *AWSClient) OpsWorksConnForRegion(ctx context.Context, region string) (*opsworks_sdkv1.OpsWorks, error) {
+	return regionalConn[*opsworks_sdkv1.OpsWorks](ctx, c, names.OpsWorks, region, nil)
 }
 
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
@@ -83,17 +119,7 @@ func (c *AWSClient) PartitionHostname(ctx context.Context, prefix string) string
 // e.g. PREFIX.us-west-2.amazonaws.com
 // The prefix should not contain a trailing period.
 func (c *AWSClient) RegionalHostname(ctx context.Context, prefix string) string {
-	return fmt.Sprintf("%s.%s.%s", prefix, c.Region, c.DNSSuffix(ctx))
-}
-
-// RDSConnForRegion returns an AWS SDK For Go v1 RDS API client for the specified AWS Region.
-// If the specified region is not the default a new "simple" client is created.
-// This new client does not use any configured endpoint override.
-func (c *AWSClient) RDSConnForRegion(ctx context.Context, region string) *rds_sdkv1.RDS {
-	if region == c.Region {
-		return c.RDSConn(ctx)
-	}
-	return rds_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
+	return fmt.Sprintf("%s.%s.%s", prefix, c.EffectiveRegion(ctx), c.DNSSuffix(ctx))
 }
 
 // S3ExpressClient returns an AWS SDK for Go v2 S3 API client suitable for use with S3 Express (directory buckets).
@@ -105,17 +131,24 @@ func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3_sdkv2.Client {
 	c.lock.Lock() // OK since a non-default client is created.
 	defer c.lock.Unlock()
 
-	if c.s3ExpressClient == nil {
+	region := c.EffectiveRegion(ctx)
+	s3ExpressClient, ok := c.s3ExpressClients[region]
+	if !ok {
 		if s3Client.Options().Region == names.GlobalRegionID {
-			c.s3ExpressClient = errs.Must(client[*s3_sdkv2.Client](ctx, c, names.S3, map[string]any{
+			s3ExpressClient = errs.Must(client[*s3_sdkv2.Client](ctx, c, names.S3, map[string]any{
 				"s3_us_east_1_regional_endpoint": "regional",
 			}))
 		} else {
-			c.s3ExpressClient = s3Client
+			s3ExpressClient = s3Client
 		}
+
+		if c.s3ExpressClients == nil {
+			c.s3ExpressClients = make(map[string]*s3_sdkv2.Client)
+		}
+		c.s3ExpressClients[region] = s3ExpressClient
 	}
 
-	return c.s3ExpressClient
+	return s3ExpressClient
 }
 
 // S3UsePathStyle returns the s3_force_path_style provider configuration value.
@@ -209,8 +242,8 @@ func (c *AWSClient) ReverseDNSPrefix(ctx context.Context) string {
 }
 
 // EC2RegionalPrivateDNSSuffix returns the EC2 private DNS suffix for the configured AWS Region.
-func (c *AWSClient) EC2RegionalPrivateDNSSuffix(context.Context) string {
-	region := c.Region
+func (c *AWSClient) EC2RegionalPrivateDNSSuffix(ctx context.Context) string {
+	region := c.EffectiveRegion(ctx)
 	if region == names.USEast1RegionID {
 		return "ec2.internal"
 	}
@@ -219,8 +252,8 @@ func (c *AWSClient) EC2RegionalPrivateDNSSuffix(context.Context) string {
 }
 
 // EC2RegionalPublicDNSSuffix returns the EC2 public DNS suffix for the configured AWS Region.
-func (c *AWSClient) EC2RegionalPublicDNSSuffix(context.Context) string {
-	region := c.Region
+func (c *AWSClient) EC2RegionalPublicDNSSuffix(ctx context.Context) string {
+	region := c.EffectiveRegion(ctx)
 	if region == names.USEast1RegionID {
 		return "compute-1"
 	}
@@ -242,13 +275,23 @@ func convertIPToDashIP(ip string) string {
 	return strings.Replace(ip, ".", "-", -1)
 }
 
-// apiClientConfig returns the AWS API client configuration parameters for the specified service.
-func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
+// apiClientConfig returns the AWS API client configuration parameters for the specified service and AWS Region.
+func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName, region string) map[string]any {
+	awsConfig, session := c.awsConfig, c.session
+	if v, ok := c.retryPolicyConfigs[servicePackageName]; ok {
+		awsConfig, session = v.awsConfig, v.session
+	}
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
-		"endpoint":         c.resolveEndpoint(ctx, servicePackageName),
+		"aws_sdkv2_config": awsConfig,
+		"endpoint":         c.resolveEndpoint(ctx, servicePackageName, region),
 		"partition":        c.Partition,
-		"session":          c.session,
+		"session":          session,
+	}
+	if region != c.Region {
+		cfg := awsConfig.Copy()
+		cfg.Region = region
+		m["aws_sdkv2_config"] = &cfg
+		m["session"] = session.Copy(aws_sdkv1.NewConfig().WithRegion(region))
 	}
 	switch servicePackageName {
 	case names.S3:
@@ -266,12 +309,17 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 	return m
 }
 
-func (c *AWSClient) resolveEndpoint(ctx context.Context, servicePackageName string) string {
+func (c *AWSClient) resolveEndpoint(ctx context.Context, servicePackageName, region string) string {
 	endpoint := c.endpoints[servicePackageName]
 	if endpoint != "" {
 		return endpoint
 	}
 
+	// The provider-wide endpoint takes precedence over envvars and config file.
+	if endpoint := c.baseEndpoint.resolve(servicePackageName, region); endpoint != "" {
+		return endpoint
+	}
+
 	// Only continue if there is an SDK v1 package. SDK v2 supports envvars and config file
 	if names.ClientSDKV1(servicePackageName) {
 		endpoint = aws_sdkv2.ToString(c.awsConfig.BaseEndpoint)
@@ -319,19 +367,43 @@ func resolveServiceBaseEndpoint(ctx context.Context, sdkID string, configs []any
 	return
 }
 
+// clientCacheKey is the key for cached AWS API clients.
+type clientCacheKey struct {
+	region             string
+	servicePackageName string
+}
+
 // conn returns the AWS SDK for Go v1 API client for the specified service.
-// The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
+// The client is configured for any per-resource Region override in Context.
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
+	return regionalConn[T](ctx, c, servicePackageName, c.EffectiveRegion(ctx), extra)
+}
+
+// regionalConn returns the AWS SDK for Go v1 API client for the specified service and AWS Region.
+// An error is returned if the Region is not allowed by the provider configuration.
+// The default service client (`extra` is empty) for each Region is lazily created and cached. In this case the AWSClient lock is held.
+func regionalConn[T any](ctx context.Context, c *AWSClient, servicePackageName, region string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
+	ctx = tflog.SetField(ctx, "tf_aws.region", region)
+
+	// Every client for a non-default Region is created here.
+	if err := c.VerifyRegionAllowed(ctx, region); err != nil {
+		var zero T
+		return zero, err
+	}
 
+	key := clientCacheKey{
+		region:             region,
+		servicePackageName: servicePackageName,
+	}
 	isDefault := len(extra) == 0
 	// Default service client is cached.
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.conns[servicePackageName]; ok {
+		if raw, ok := c.conns[key]; ok {
 			if conn, ok := raw.(T); ok {
 				return conn, nil
 			} else {
@@ -355,7 +427,7 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, e
 		return zero, fmt.Errorf("no AWS SDK v1 API client factory: %s", servicePackageName)
 	}
 
-	config := c.apiClientConfig(ctx, servicePackageName)
+	config := c.apiClientConfig(ctx, servicePackageName, region)
 	maps.Copy(config, extra) // Extras overwrite per-service defaults.
 	conn, err := v.NewConn(ctx, config)
 	if err != nil {
@@ -375,25 +447,43 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, e
 
 	// Default service client is cached.
 	if isDefault {
-		c.conns[servicePackageName] = conn
+		c.conns[key] = conn
 	}
 
 	return conn, nil
 }
 
 // client returns the AWS SDK for Go v2 API client for the specified service.
-// The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
+// The client is configured for any per-resource Region override in Context.
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
+	return regionalClient[T](ctx, c, servicePackageName, c.EffectiveRegion(ctx), extra)
+}
+
+// regionalClient returns the AWS SDK for Go v2 API client for the specified service and AWS Region.
+// An error is returned if the Region is not allowed by the provider configuration.
+// The default service client (`extra` is empty) for each Region is lazily created and cached. In this case the AWSClient lock is held.
+func regionalClient[T any](ctx context.Context, c *AWSClient, servicePackageName, region string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
+	ctx = tflog.SetField(ctx, "tf_aws.region", region)
 
+	// Every client for a non-default Region is created here.
+	if err := c.VerifyRegionAllowed(ctx, region); err != nil {
+		var zero T
+		return zero, err
+	}
+
+	key := clientCacheKey{
+		region:             region,
+		servicePackageName: servicePackageName,
+	}
 	isDefault := len(extra) == 0
 	// Default service client is cached.
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.clients[servicePackageName]; ok {
+		if raw, ok := c.clients[key]; ok {
 			if client, ok := raw.(T); ok {
 				return client, nil
 			} else {
@@ -417,7 +507,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 		return zero, fmt.Errorf("no AWS SDK v2 API client factory: %s", servicePackageName)
 	}
 
-	config := c.apiClientConfig(ctx, servicePackageName)
+	config := c.apiClientConfig(ctx, servicePackageName, region)
 	maps.Copy(config, extra) // Extras overwrite per-service defaults.
 	client, err := v.NewClient(ctx, config)
 	if err != nil {
@@ -428,7 +518,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
 	if isDefault {
-		c.clients[servicePackageName] = client
+		c.clients[key] = client
 	}
 
 	return client, nil
@@ -5,6 +5,7 @@ package conns
 
 import (
 	"context"
+	"strings"
 	"testing"
 )
 
@@ -94,6 +95,62 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 	}
 }
 
+func TestAWSClientEffectiveRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	testCases := []struct {
+		Name           string
+		Context        func(context.Context) context.Context
+		OverrideRegion string
+		Expected       string
+	}{
+		{
+			Name:     "no resource context",
+			Context:  func(ctx context.Context) context.Context { return ctx },
+			Expected: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name: "no override",
+			Context: func(ctx context.Context) context.Context {
+				return NewResourceContext(ctx, "ec2", "VPC", "aws_vpc")
+			},
+			Expected: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name: "override",
+			Context: func(ctx context.Context) context.Context {
+				return NewResourceContext(ctx, "ec2", "VPC", "aws_vpc")
+			},
+			OverrideRegion: "eu-west-1", //lintignore:AWSAT003
+			Expected:       "eu-west-1", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &AWSClient{
+				dnsSuffix: "amazonaws.com",
+				Region:    "us-west-2", //lintignore:AWSAT003
+			}
+			ctx := testCase.Context(context.TODO())
+			if v, ok := FromContext(ctx); ok {
+				v.OverrideRegion = testCase.OverrideRegion
+			}
+
+			if got := client.EffectiveRegion(ctx); got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+
+			if got, expected := client.RegionalHostname(ctx, "test"), "test."+testCase.Expected+".amazonaws.com"; got != expected {
+				t.Errorf("got %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
 func TestAWSClientEC2PrivateDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	t.Parallel()
 
@@ -181,3 +238,81 @@ func TestAWSClientEC2PublicDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-f
 		})
 	}
 }
+
+func TestAWSClientVerifyRegionAllowed(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+	testCases := []struct {
+		Name          string
+		AWSClient     *AWSClient
+		Region        string
+		ExpectedError bool
+	}{
+		{
+			Name:      "no restrictions",
+			AWSClient: &AWSClient{},
+			Region:    "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name: "allowed",
+			AWSClient: &AWSClient{
+				allowedRegions: []string{"us-east-1", "us-west-2"}, //lintignore:AWSAT003
+			},
+			Region: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name: "not allowed",
+			AWSClient: &AWSClient{
+				allowedRegions: []string{"us-east-1"}, //lintignore:AWSAT003
+			},
+			Region:        "us-west-2", //lintignore:AWSAT003
+			ExpectedError: true,
+		},
+		{
+			Name: "not forbidden",
+			AWSClient: &AWSClient{
+				forbiddenRegions: []string{"us-east-1"}, //lintignore:AWSAT003
+			},
+			Region: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name: "forbidden",
+			AWSClient: &AWSClient{
+				forbiddenRegions: []string{"us-east-1", "us-west-2"}, //lintignore:AWSAT003
+			},
+			Region:        "us-west-2", //lintignore:AWSAT003
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.AWSClient.VerifyRegionAllowed(ctx, testCase.Region)
+
+			if got, want := err != nil, testCase.ExpectedError; got != want {
+				t.Errorf("got error %v, expected error %t", err, want)
+			}
+		})
+	}
+}
+
+func TestAWSClientOpsWorksConnForRegionNotAllowed(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+	client := &AWSClient{
+		Region:           "us-west-2",           //lintignore:AWSAT003
+		forbiddenRegions: []string{"eu-west-1"}, //lintignore:AWSAT003
+		conns:            make(map[clientCacheKey]any),
+	}
+
+	_, err := client.OpsWorksConnForRegion(ctx, "eu-west-1") //lintignore:AWSAT003
+
+	if err == nil || !strings.Contains(err.Error(), "AWS Region not allowed") {
+		t.Errorf("got error %v, expected AWS Region not allowed", err)
+	}
+}
@@ -6,6 +6,7 @@ package conns
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -21,35 +22,50 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
 
 type Config struct {
 	AccessKey                      string
+	AdaptiveRateLimiting           bool
+	AdaptiveRateLimitingMaxRate    int
 	AllowedAccountIds              []string
-	AssumeRole                     *awsbase.AssumeRole
+	AllowedRegions                 []string
+	APICallLogPath                 string
+	AssumeRole                     []*awsbase.AssumeRole // Assumed in order.
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
+	EndpointURL                    string
+	EndpointURLTemplate            string
 	Endpoints                      map[string]string
 	ForbiddenAccountIds            []string
+	ForbiddenRegions               []string
 	HTTPProxy                      *string
 	HTTPSProxy                     *string
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
+	LookupCacheTTL                 time.Duration
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
+	ReadOnly                       bool
+	ReadOnlyExceptions             map[string][]string
 	Region                         string
+	RequiredTagsConfig             *tftags.RequiredConfig
 	RetryMode                      aws_sdkv2.RetryMode
+	RetryPolicies                  map[string]*RetryPolicy
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceConcurrency             map[string]int
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -70,6 +86,17 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	ctx, logger := logging.NewTfLogger(ctx)
 
+	baseEndpoint := baseEndpoint{
+		url:      c.EndpointURL,
+		template: c.EndpointURLTemplate,
+	}
+	endpoint := func(servicePackageName string) string {
+		if v := c.Endpoints[servicePackageName]; v != "" {
+			return v
+		}
+		return baseEndpoint.resolve(servicePackageName, c.Region)
+	}
+
 	const (
 		maxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
 	)
@@ -89,7 +116,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		CallerName:                     "Terraform AWS Provider",
 		EC2MetadataServiceEnableState:  c.EC2MetadataServiceEnableState,
 		ForbiddenAccountIds:            c.ForbiddenAccountIds,
-		IamEndpoint:                    c.Endpoints[names.IAM],
+		IamEndpoint:                    endpoint(names.IAM),
 		Insecure:                       c.Insecure,
 		HTTPClient:                     client.HTTPClient(ctx),
 		HTTPProxy:                      c.HTTPProxy,
@@ -105,8 +132,8 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		SecretKey:                      c.SecretKey,
 		SkipCredsValidation:            c.SkipCredsValidation,
 		SkipRequestingAccountId:        c.SkipRequestingAccountId,
-		SsoEndpoint:                    c.Endpoints[names.SSO],
-		StsEndpoint:                    c.Endpoints[names.STS],
+		SsoEndpoint:                    endpoint(names.SSO),
+		StsEndpoint:                    endpoint(names.STS),
 		SuppressDebugLog:               c.SuppressDebugLog,
 		Token:                          c.Token,
 		TokenBucketRateLimiterCapacity: c.TokenBucketRateLimiterCapacity,
@@ -114,8 +141,16 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		UseFIPSEndpoint:                c.UseFIPSEndpoint,
 	}
 
-	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
-		awsbaseConfig.AssumeRole = c.AssumeRole
+	if len(c.AssumeRole) > 1 {
+		for i, v := range c.AssumeRole {
+			if v.RoleARN == "" {
+				return nil, sdkdiag.AppendErrorf(diags, "assume_role %d of %d: role_arn is required when chaining IAM Roles", i+1, len(c.AssumeRole))
+			}
+		}
+	}
+
+	if len(c.AssumeRole) > 0 && c.AssumeRole[0].RoleARN != "" {
+		awsbaseConfig.AssumeRole = c.AssumeRole[0]
 	}
 
 	if c.CustomCABundle != "" {
@@ -166,8 +201,34 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 	c.Region = cfg.Region
 
+	if err := c.VerifyRegionAllowed(c.Region); err != nil {
+		return nil, sdkdiag.AppendFromErr(diags, err)
+	}
+
 	awsbaseConfig.SkipCredsValidation = skipCredsValidation
 
+	if len(c.AssumeRole) > 1 {
+		tflog.Debug(ctx, "Configuring chained IAM Role assumption", map[string]any{
+			"tf_aws.assume_role.count": len(c.AssumeRole),
+		})
+		chain := assumeRoleChain(cfg, c.AssumeRole, awsbaseConfig.StsEndpoint, awsbaseConfig.StsRegion)
+
+		// Assume each IAM Role in turn so that any failure is reported against the hop that caused it.
+		if !skipCredsValidation {
+			for _, credentials := range chain {
+				if _, err := credentials.Retrieve(ctx); err != nil {
+					return nil, append(diags, diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  "Cannot assume IAM Role",
+						Detail:   err.Error(),
+					})
+				}
+			}
+		}
+
+		cfg.Credentials = chain[len(chain)-1]
+	}
+
 	tflog.Debug(ctx, "Creating AWS SDK v1 session")
 	session, awsDiags := awsbasev1.GetSession(ctx, &cfg, &awsbaseConfig)
 
@@ -183,6 +244,37 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	if c.APICallLogPath != "" {
+		tflog.Info(ctx, "Configuring AWS API call log", map[string]any{
+			"tf_aws.api_call_log_path": c.APICallLogPath,
+		})
+		apiCallLogger, err := openAPICallLogger(c.APICallLogPath)
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "opening AWS API call log (%s): %s", c.APICallLogPath, err)
+		}
+		session.Handlers.Send.PushFrontNamed(apiCallLogger.handlerV1())
+		session.Config.HTTPClient = apiCallLogger.wrapHTTPClientV1(session.Config.HTTPClient)
+		cfg.APIOptions = append(cfg.APIOptions, apiCallLogger.addMiddlewareV2)
+		cfg.HTTPClient = apiCallLogger.wrapHTTPClientV2(cfg.HTTPClient)
+	}
+
+	if c.AdaptiveRateLimiting {
+		tflog.Info(ctx, "Configuring adaptive rate limiting")
+		limiters := newAdaptiveRateLimiters(c.AdaptiveRateLimitingMaxRate)
+		session.Handlers.Sign.PushFrontNamed(limiters.signHandlerV1())
+		session.Handlers.Retry.PushBackNamed(limiters.retryHandlerV1())
+		session.Handlers.Complete.PushBackNamed(limiters.completeHandlerV1())
+		cfg.APIOptions = append(cfg.APIOptions, limiters.addMiddlewareV2)
+	}
+
+	if tracing.Enabled() {
+		tflog.Info(ctx, "Configuring AWS API call tracing")
+		start, end := tracingHandlersV1()
+		session.Handlers.Validate.PushFrontNamed(start)
+		session.Handlers.Complete.PushBackNamed(end)
+		cfg.APIOptions = append(cfg.APIOptions, addTracingMiddlewareV2)
+	}
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partition, awsDiags := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	for _, d := range awsDiags {
@@ -204,33 +296,85 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, sdkdiag.AppendErrorf(diags, err.Error())
 	}
 
+	if c.ReadOnly {
+		// Installed after the account details are retrieved so that all subsequently created AWS API clients inherit it.
+		tflog.Info(ctx, "Configuring read-only mode", map[string]any{
+			"tf_aws.read_only.exceptions": c.ReadOnlyExceptions,
+		})
+		readOnly := newReadOnlyPolicy(c.ReadOnlyExceptions)
+		session.Handlers.Validate.PushFrontNamed(readOnly.handlerV1())
+		cfg.APIOptions = append(cfg.APIOptions, readOnly.addMiddlewareV2)
+	}
+
 	dnsSuffix := "amazonaws.com"
 	if p, ok := endpoints_sdkv1.PartitionForRegion(endpoints_sdkv1.DefaultPartitions(), c.Region); ok {
 		dnsSuffix = p.DNSSuffix()
 	}
 
 	client.AccountID = accountID
+	client.allowedRegions = c.AllowedRegions
 	client.DefaultTagsConfig = c.DefaultTagsConfig
 	client.dnsSuffix = dnsSuffix
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
 	client.Region = c.Region
+	client.RequiredTagsConfig = c.RequiredTagsConfig
 	client.SetHTTPClient(ctx, session.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.session = session
 
 	// Used for lazy-loading AWS API clients.
 	client.awsConfig = &cfg
-	client.clients = make(map[string]any, 0)
-	client.conns = make(map[string]any, 0)
+	client.clients = make(map[clientCacheKey]any, 0)
+	client.conns = make(map[clientCacheKey]any, 0)
+	client.baseEndpoint = baseEndpoint
 	client.endpoints = c.Endpoints
+	client.forbiddenRegions = c.ForbiddenRegions
 	client.logger = logger
+	if c.LookupCacheTTL > 0 {
+		client.lookupCache = newLookupCache(c.LookupCacheTTL)
+	}
+	// Retry policies are applied last so that the per-service configurations include all other customizations.
+	client.retryPolicyConfigs = make(map[string]retryPolicyConfig, len(c.RetryPolicies))
+	for servicePackageName, policy := range c.RetryPolicies {
+		client.retryPolicyConfigs[servicePackageName] = retryPolicyConfig{
+			awsConfig: policy.awsConfigV2(&cfg),
+			session:   policy.sessionV1(session),
+		}
+	}
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
+	client.serviceSemaphores = make(map[string]tfsync.Semaphore, len(c.ServiceConcurrency))
+	for servicePackageName, limit := range c.ServiceConcurrency {
+		if limit > 0 {
+			client.serviceSemaphores[servicePackageName] = tfsync.NewSemaphore(limit)
+		}
+	}
 	client.stsRegion = c.STSRegion
 
 	return client, diags
 }
 
+// VerifyRegionAllowed returns an error if the specified AWS Region is not allowed by the provider configuration.
+func (c *Config) VerifyRegionAllowed(region string) error {
+	return verifyRegionAllowed(region, c.AllowedRegions, c.ForbiddenRegions)
+}
+
+func verifyRegionAllowed(region string, allowedRegions, forbiddenRegions []string) error {
+	if len(forbiddenRegions) > 0 {
+		if slices.Contains(forbiddenRegions, region) {
+			return fmt.Errorf("AWS Region not allowed: %s", region)
+		}
+	}
+
+	if len(allowedRegions) > 0 {
+		if !slices.Contains(allowedRegions, region) {
+			return fmt.Errorf("AWS Region not allowed: %s", region)
+		}
+	}
+
+	return nil
+}
+
 func baseSeverityToSDKSeverity(s basediag.Severity) diag.Severity {
 	switch s {
 	case basediag.SeverityWarning:
@@ -30,24 +30,28 @@ var (
 // InContext represents the resource information kept in Context.
 type InContext struct {
 	IsDataSource       bool   // Data source?
+	OverrideRegion     string // Per-resource Region override, empty if the provider's configured Region is used
 	ResourceName       string // Friendly resource name, e.g. "Subnet"
 	ServicePackageName string // Canonical name defined as a constant in names package
+	TypeName           string // Terraform resource type name, e.g. "aws_subnet"
 }
 
-func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
 	v := InContext{
 		IsDataSource:       true,
 		ResourceName:       resourceName,
 		ServicePackageName: servicePackageName,
+		TypeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewResourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
 	v := InContext{
 		ResourceName:       resourceName,
 		ServicePackageName: servicePackageName,
+		TypeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
@@ -0,0 +1,24 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"strings"
+)
+
+// baseEndpoint is a provider-wide AWS API endpoint, applied to every service that has no service-specific endpoint configured.
+type baseEndpoint struct {
+	url      string
+	template string
+}
+
+// resolve returns the base endpoint for the specified service and AWS Region.
+// The `{service}` and `{region}` placeholders in a templated endpoint are replaced with the service package name and Region.
+func (e baseEndpoint) resolve(servicePackageName, region string) string {
+	if e.template != "" {
+		return strings.NewReplacer("{service}", servicePackageName, "{region}", region).Replace(e.template)
+	}
+
+	return e.url
+}
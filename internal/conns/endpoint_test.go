@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestBaseEndpointResolve(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		baseEndpoint baseEndpoint
+		expected     string
+	}{
+		"not configured": {},
+		"url": {
+			baseEndpoint: baseEndpoint{url: "https://endpoint.test/"},
+			expected:     "https://endpoint.test/",
+		},
+		"template": {
+			baseEndpoint: baseEndpoint{template: "https://{service}.{region}.endpoint.test/{service}"},
+			expected:     "https://sqs.us-west-2.endpoint.test/sqs", //lintignore:AWSAT003
+		},
+		"template without placeholders": {
+			baseEndpoint: baseEndpoint{template: "https://endpoint.test/"},
+			expected:     "https://endpoint.test/",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.baseEndpoint.resolve(names.SQS, "us-west-2"), testCase.expected; got != want { //lintignore:AWSAT003
+				t.Errorf("resolve = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestResolveEndpoint(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := &AWSClient{
+		baseEndpoint: baseEndpoint{template: "https://{service}.{region}.endpoint.test/"},
+		endpoints: map[string]string{
+			names.SQS: "https://sqs.endpoint.test/",
+		},
+	}
+
+	//lintignore:AWSAT003
+	if got, want := client.resolveEndpoint(ctx, names.SQS, "us-west-2"), "https://sqs.endpoint.test/"; got != want {
+		t.Errorf("service endpoint: got %q, want %q", got, want)
+	}
+	//lintignore:AWSAT003
+	if got, want := client.resolveEndpoint(ctx, names.SNS, "eu-west-1"), "https://sns.eu-west-1.endpoint.test/"; got != want {
+		t.Errorf("base endpoint: got %q, want %q", got, want)
+	}
+}
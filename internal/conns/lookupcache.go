@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// lookupCache is a read-through cache of AWS API lookup results with a fixed time-to-live.
+// A cache lives for the lifetime of a provider configuration, i.e. a single Terraform run.
+type lookupCache struct {
+	entries map[string]lookupCacheEntry
+	hits    int64
+	misses  int64
+	mu      sync.Mutex
+	now     func() time.Time
+	ttl     time.Duration
+}
+
+type lookupCacheEntry struct {
+	expires time.Time
+	value   any
+}
+
+func newLookupCache(ttl time.Duration) *lookupCache {
+	return &lookupCache{
+		entries: make(map[string]lookupCacheEntry),
+		now:     time.Now,
+		ttl:     ttl,
+	}
+}
+
+// get returns the unexpired cached value for the specified key.
+func (c *lookupCache) get(key string) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if ok && c.now().Before(entry.expires) {
+		c.hits++
+		return entry.value, true
+	}
+
+	if ok {
+		delete(c.entries, key)
+	}
+	c.misses++
+
+	return nil, false
+}
+
+func (c *lookupCache) put(key string, value any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = lookupCacheEntry{
+		expires: c.now().Add(c.ttl),
+		value:   value,
+	}
+}
+
+func (c *lookupCache) stats() (int64, int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.hits, c.misses
+}
+
+// lookupCacheKey returns the cache key for the specified service, AWS Region, operation and input.
+// The input is normalized by its JSON encoding.
+func lookupCacheKey(servicePackageName, region, operation string, input any) (string, error) {
+	b, err := json.Marshal(input)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s|%s|%s|%s", servicePackageName, region, operation, b), nil
+}
+
+// CachedLookup returns the result of calling f, using the provider's lookup cache if it is enabled.
+// The result is cached by service, AWS Region, operation and input. Errors are not cached.
+// Only use for lookups of data that doesn't change during a Terraform run, e.g. in data sources.
+// Cached results are shared by all callers and must not be modified.
+func CachedLookup[T any](ctx context.Context, c *AWSClient, servicePackageName, operation string, input any, f func(context.Context) (T, error)) (T, error) {
+	cache := c.lookupCache
+	if cache == nil {
+		return f(ctx)
+	}
+
+	key, err := lookupCacheKey(servicePackageName, c.EffectiveRegion(ctx), operation, input)
+	if err != nil {
+		tflog.Warn(ctx, "Lookup cache key", map[string]any{
+			"error": err.Error(),
+		})
+		return f(ctx)
+	}
+
+	if v, ok := cache.get(key); ok {
+		if v, ok := v.(T); ok {
+			hits, misses := cache.stats()
+			tflog.Debug(ctx, "Lookup cache hit", map[string]any{
+				"tf_aws.lookup_cache.hits":      hits,
+				"tf_aws.lookup_cache.misses":    misses,
+				"tf_aws.lookup_cache.operation": operation,
+			})
+			return v, nil
+		}
+	}
+
+	hits, misses := cache.stats()
+	tflog.Debug(ctx, "Lookup cache miss", map[string]any{
+		"tf_aws.lookup_cache.hits":      hits,
+		"tf_aws.lookup_cache.misses":    misses,
+		"tf_aws.lookup_cache.operation": operation,
+	})
+
+	v, err := f(ctx)
+	if err != nil {
+		return v, err
+	}
+
+	cache.put(key, v)
+
+	return v, nil
+}
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLookupCacheExpiry(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := newLookupCache(time.Minute)
+	cache.now = func() time.Time { return now }
+
+	if _, ok := cache.get("k"); ok {
+		t.Fatal("unexpected hit on empty cache")
+	}
+
+	cache.put("k", "v")
+
+	if v, ok := cache.get("k"); !ok || v != "v" {
+		t.Fatalf("get: got (%v, %t), want (v, true)", v, ok)
+	}
+
+	now = now.Add(time.Minute)
+
+	if _, ok := cache.get("k"); ok {
+		t.Error("unexpected hit on expired entry")
+	}
+
+	if hits, misses := cache.stats(); hits != 1 || misses != 2 {
+		t.Errorf("stats: got (%d, %d), want (1, 2)", hits, misses)
+	}
+}
+
+func TestLookupCacheKey(t *testing.T) {
+	t.Parallel()
+
+	type input struct {
+		Names []string
+	}
+
+	//lintignore:AWSAT003
+	k1, err := lookupCacheKey("ec2", "us-west-2", "DescribeImages", &input{Names: []string{"a"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	//lintignore:AWSAT003
+	k2, err := lookupCacheKey("ec2", "us-west-2", "DescribeImages", &input{Names: []string{"a"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	//lintignore:AWSAT003
+	k3, err := lookupCacheKey("ec2", "us-east-1", "DescribeImages", &input{Names: []string{"a"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if k1 != k2 {
+		t.Errorf("expected equal keys for equal inputs: %q, %q", k1, k2)
+	}
+	if k1 == k3 {
+		t.Errorf("expected different keys for different Regions: %q", k1)
+	}
+}
+
+func TestCachedLookup(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var calls int
+	f := func(context.Context) (int, error) {
+		calls++
+		return calls, nil
+	}
+
+	// Disabled.
+	client := &AWSClient{}
+	for i := 0; i < 2; i++ {
+		if _, err := CachedLookup(ctx, client, "ec2", "DescribeImages", nil, f); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, want := calls, 2; got != want {
+		t.Errorf("calls with cache disabled: got %d, want %d", got, want)
+	}
+
+	// Enabled.
+	calls = 0
+	client = &AWSClient{lookupCache: newLookupCache(time.Hour)}
+	for i := 0; i < 2; i++ {
+		v, err := CachedLookup(ctx, client, "ec2", "DescribeImages", nil, f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := v, 1; got != want {
+			t.Errorf("value: got %d, want %d", got, want)
+		}
+	}
+	if got, want := calls, 1; got != want {
+		t.Errorf("calls with cache enabled: got %d, want %d", got, want)
+	}
+
+	// Errors are not cached.
+	calls = 0
+	errFailed := errors.New("failed")
+	g := func(context.Context) (int, error) {
+		calls++
+		return 0, errFailed
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := CachedLookup(ctx, client, "sts", "GetCallerIdentity", nil, g); !errors.Is(err, errFailed) {
+			t.Fatalf("expected error, got %v", err)
+		}
+	}
+	if got, want := calls, 2; got != want {
+		t.Errorf("calls with errors: got %d, want %d", got, want)
+	}
+}
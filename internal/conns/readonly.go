@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	readOnlyHandlerName = "TFAWSReadOnly"
+)
+
+// readOnlyOperationPrefixes are the AWS API operation name prefixes allowed in read-only mode.
+var readOnlyOperationPrefixes = []string{
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+}
+
+// ReadOnlyError is returned when an AWS API operation is blocked in read-only mode.
+type ReadOnlyError struct {
+	IsDataSource bool
+	Operation    string
+	ResourceName string
+	ServiceID    string
+}
+
+func (e *ReadOnlyError) Error() string {
+	var sb strings.Builder
+
+	sb.WriteString("provider is configured with read_only = true")
+	if e.ResourceName != "" {
+		if e.IsDataSource {
+			sb.WriteString(fmt.Sprintf(": data source %q", e.ResourceName))
+		} else {
+			sb.WriteString(fmt.Sprintf(": resource %q", e.ResourceName))
+		}
+	}
+	sb.WriteString(fmt.Sprintf(" attempted mutating AWS API operation %s:%s", e.ServiceID, e.Operation))
+	sb.WriteString(". To allow the operation, add it to a read_only_exceptions block")
+
+	return sb.String()
+}
+
+// readOnlyPolicy decides whether an AWS API operation is allowed in read-only mode.
+type readOnlyPolicy struct {
+	// Allowed operations, keyed by normalized AWS SDK service ID.
+	// An empty value allows all of the service's operations.
+	exceptions map[string][]string
+}
+
+// newReadOnlyPolicy returns a read-only policy with the specified exceptions.
+// Exceptions are keyed by service package name, e.g. "sts".
+func newReadOnlyPolicy(exceptions map[string][]string) *readOnlyPolicy {
+	p := &readOnlyPolicy{
+		exceptions: make(map[string][]string, len(exceptions)),
+	}
+
+	for servicePackageName, operations := range exceptions {
+		serviceID := names.SDKID(servicePackageName)
+		if serviceID == "" {
+			serviceID = servicePackageName
+		}
+		key := normalizeServiceID(serviceID)
+		p.exceptions[key] = append(p.exceptions[key], operations...)
+	}
+
+	return p
+}
+
+func (p *readOnlyPolicy) isAllowed(serviceID, operation string) bool {
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(operation, prefix) {
+			return true
+		}
+	}
+
+	if operations, ok := p.exceptions[normalizeServiceID(serviceID)]; ok {
+		return len(operations) == 0 || slices.Contains(operations, operation)
+	}
+
+	return false
+}
+
+func (p *readOnlyPolicy) check(ctx context.Context, serviceID, operation string) error {
+	if p.isAllowed(serviceID, operation) {
+		return nil
+	}
+
+	err := &ReadOnlyError{
+		Operation: operation,
+		ServiceID: serviceID,
+	}
+	if v, ok := FromContext(ctx); ok {
+		err.IsDataSource = v.IsDataSource
+		err.ResourceName = v.ResourceName
+	}
+
+	return err
+}
+
+// handlerV1 returns an AWS SDK for Go v1 request handler that enforces the policy.
+func (p *readOnlyPolicy) handlerV1() request_sdkv1.NamedHandler {
+	return request_sdkv1.NamedHandler{
+		Name: readOnlyHandlerName,
+		Fn: func(r *request_sdkv1.Request) {
+			if err := p.check(r.Context(), r.ClientInfo.ServiceID, r.Operation.Name); err != nil {
+				r.Error = err
+			}
+		},
+	}
+}
+
+// addMiddlewareV2 adds AWS SDK for Go v2 middleware that enforces the policy.
+// The middleware runs after the service metadata (service ID and operation name) is registered.
+func (p *readOnlyPolicy) addMiddlewareV2(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc(readOnlyHandlerName, func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		if err := p.check(ctx, awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx)); err != nil {
+			return middleware.InitializeOutput{}, middleware.Metadata{}, err
+		}
+
+		return next.HandleInitialize(ctx, in)
+	}), middleware.After)
+}
+
+// normalizeServiceID normalizes an AWS SDK service ID, e.g. "Secrets Manager" -> "secretsmanager".
+func normalizeServiceID(serviceID string) string {
+	return strings.ToLower(strings.ReplaceAll(serviceID, " ", ""))
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestReadOnlyPolicyIsAllowed(t *testing.T) {
+	t.Parallel()
+
+	policy := newReadOnlyPolicy(map[string][]string{
+		"lambda":         {"Invoke"},
+		"secretsmanager": {},
+	})
+
+	testCases := map[string]struct {
+		serviceID string
+		operation string
+		expected  bool
+	}{
+		"describe": {
+			serviceID: "EC2",
+			operation: "DescribeSubnets",
+			expected:  true,
+		},
+		"get": {
+			serviceID: "IAM",
+			operation: "GetRole",
+			expected:  true,
+		},
+		"head": {
+			serviceID: "S3",
+			operation: "HeadObject",
+			expected:  true,
+		},
+		"list": {
+			serviceID: "S3",
+			operation: "ListObjectsV2",
+			expected:  true,
+		},
+		"create": {
+			serviceID: "EC2",
+			operation: "CreateSubnet",
+			expected:  false,
+		},
+		"delete": {
+			serviceID: "S3",
+			operation: "DeleteObject",
+			expected:  false,
+		},
+		"exception operation": {
+			serviceID: "Lambda",
+			operation: "Invoke",
+			expected:  true,
+		},
+		"exception other operation": {
+			serviceID: "Lambda",
+			operation: "UpdateFunctionCode",
+			expected:  false,
+		},
+		"exception all operations": {
+			serviceID: "Secrets Manager",
+			operation: "PutSecretValue",
+			expected:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := policy.isAllowed(testCase.serviceID, testCase.operation), testCase.expected; got != want {
+				t.Errorf("isAllowed(%q, %q) = %t, want %t", testCase.serviceID, testCase.operation, got, want)
+			}
+		})
+	}
+}
+
+func TestReadOnlyPolicyCheck(t *testing.T) {
+	t.Parallel()
+
+	policy := newReadOnlyPolicy(nil)
+	ctx := NewResourceContext(context.TODO(), "ec2", "Subnet", "aws_subnet")
+
+	err := policy.check(ctx, "EC2", "CreateSubnet")
+
+	var roErr *ReadOnlyError
+	if !errors.As(err, &roErr) {
+		t.Fatalf("expected ReadOnlyError, got %v", err)
+	}
+
+	for _, want := range []string{`resource "Subnet"`, "EC2:CreateSubnet"} {
+		if got := err.Error(); !strings.Contains(got, want) {
+			t.Errorf("error %q does not contain %q", got, want)
+		}
+	}
+
+	if err := policy.check(ctx, "EC2", "DescribeSubnets"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	client_sdkv1 "github.com/aws/aws-sdk-go/aws/client"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+const (
+	retryPolicyHandlerName = "TFAWSRetryPolicy"
+)
+
+// RetryPolicy is a per-service retry policy from provider configuration.
+// It is applied in addition to any retry handling built into the provider.
+type RetryPolicy struct {
+	// ErrorCodes are additional retryable AWS API error codes.
+	ErrorCodes []string
+	// ErrorMessages are substrings of additional retryable AWS API error messages.
+	ErrorMessages []string
+	// MaxAttempts overrides the maximum number of attempts, including the initial attempt. 0 means no override.
+	MaxAttempts int
+	// MaxBackoff overrides the maximum backoff delay between attempts. 0 means no override.
+	MaxBackoff time.Duration
+}
+
+// retryPolicyConfig is the AWS SDK configuration for a service with a retry policy applied.
+type retryPolicyConfig struct {
+	awsConfig *aws_sdkv2.Config
+	session   *session_sdkv1.Session
+}
+
+// isErrorRetryable returns whether the specified AWS API error code and message are retryable.
+func (p *RetryPolicy) isErrorRetryable(code, message string) bool {
+	if slices.Contains(p.ErrorCodes, code) {
+		return true
+	}
+
+	return slices.ContainsFunc(p.ErrorMessages, func(v string) bool {
+		return strings.Contains(message, v)
+	})
+}
+
+// awsConfigV2 returns a copy of the AWS SDK for Go v2 configuration that applies the policy.
+func (p *RetryPolicy) awsConfigV2(cfg *aws_sdkv2.Config) *aws_sdkv2.Config {
+	v := cfg.Copy()
+
+	if p.MaxAttempts > 0 {
+		v.RetryMaxAttempts = p.MaxAttempts // Applied by the client after any service-specific Retryer customization.
+	}
+
+	if retryer := v.Retryer; retryer != nil {
+		v.Retryer = func() aws_sdkv2.Retryer {
+			return p.retryerV2(retryer())
+		}
+	}
+
+	return &v
+}
+
+// retryerV2 returns an AWS SDK for Go v2 Retryer that applies the policy to the specified Retryer.
+func (p *RetryPolicy) retryerV2(r aws_sdkv2.Retryer) aws_sdkv2.RetryerV2 {
+	v, ok := r.(aws_sdkv2.RetryerV2)
+	if !ok {
+		v = &wrappedAsRetryerV2{Retryer: r}
+	}
+
+	if len(p.ErrorCodes) > 0 || len(p.ErrorMessages) > 0 {
+		v = AddIsErrorRetryables(v, retry_sdkv2.IsErrorRetryableFunc(func(err error) aws_sdkv2.Ternary {
+			if apiErr, ok := errs.As[smithy.APIError](err); ok && p.isErrorRetryable(apiErr.ErrorCode(), apiErr.ErrorMessage()) {
+				return aws_sdkv2.TrueTernary
+			}
+
+			return aws_sdkv2.UnknownTernary // Delegate to configured Retryer.
+		}))
+	}
+
+	if p.MaxBackoff > 0 {
+		v = &withMaxBackoffDelay{
+			RetryerV2:  v,
+			maxBackoff: p.MaxBackoff,
+		}
+	}
+
+	// MaxAttempts is applied by setting RetryMaxAttempts on the client configuration.
+
+	return v
+}
+
+// sessionV1 returns a copy of the AWS SDK for Go v1 session that applies the policy.
+func (p *RetryPolicy) sessionV1(sess *session_sdkv1.Session) *session_sdkv1.Session {
+	sess = sess.Copy()
+
+	if len(p.ErrorCodes) > 0 || len(p.ErrorMessages) > 0 {
+		sess.Handlers.Retry.PushBackNamed(request_sdkv1.NamedHandler{
+			Name: retryPolicyHandlerName,
+			Fn: func(r *request_sdkv1.Request) {
+				if awsErr, ok := errs.As[awserr.Error](r.Error); ok && p.isErrorRetryable(awsErr.Code(), awsErr.Message()) {
+					r.Retryable = aws_sdkv1.Bool(true)
+				}
+			},
+		})
+	}
+
+	if p.MaxAttempts > 0 || p.MaxBackoff > 0 {
+		var retryer request_sdkv1.Retryer
+		if v, ok := sess.Config.Retryer.(request_sdkv1.Retryer); ok {
+			retryer = v
+		} else {
+			maxRetries := client_sdkv1.DefaultRetryerMaxNumRetries
+			if v := sess.Config.MaxRetries; v != nil && aws_sdkv1.IntValue(v) != aws_sdkv1.UseServiceDefaultRetries {
+				maxRetries = aws_sdkv1.IntValue(v)
+			}
+			retryer = client_sdkv1.DefaultRetryer{NumMaxRetries: maxRetries}
+		}
+
+		v := &retryerV1{
+			Retryer:    retryer,
+			maxBackoff: p.MaxBackoff,
+			maxRetries: retryer.MaxRetries(),
+		}
+		if p.MaxAttempts > 0 {
+			v.maxRetries = p.MaxAttempts - 1
+			sess.Config.MaxRetries = aws_sdkv1.Int(v.maxRetries)
+		}
+		sess.Config.Retryer = v
+	}
+
+	return sess
+}
+
+// wrappedAsRetryerV2 is an AWS SDK for Go v2 RetryerV2 that wraps a Retryer.
+type wrappedAsRetryerV2 struct {
+	aws_sdkv2.Retryer
+}
+
+func (r *wrappedAsRetryerV2) GetAttemptToken(context.Context) (func(error) error, error) {
+	return r.Retryer.GetInitialToken(), nil
+}
+
+// withMaxBackoffDelay is an AWS SDK for Go v2 Retryer that caps the backoff delay of the wrapped Retryer.
+type withMaxBackoffDelay struct {
+	aws_sdkv2.RetryerV2
+	maxBackoff time.Duration
+}
+
+func (r *withMaxBackoffDelay) RetryDelay(attempt int, err error) (time.Duration, error) {
+	delay, err := r.RetryerV2.RetryDelay(attempt, err)
+	if err != nil {
+		return 0, err
+	}
+
+	return min(delay, r.maxBackoff), nil
+}
+
+// retryerV1 is an AWS SDK for Go v1 Retryer with an alternate maximum number of retries and maximum backoff.
+type retryerV1 struct {
+	request_sdkv1.Retryer
+	maxBackoff time.Duration
+	maxRetries int
+}
+
+func (r *retryerV1) MaxRetries() int {
+	return r.maxRetries
+}
+
+func (r *retryerV1) RetryRules(req *request_sdkv1.Request) time.Duration {
+	delay := r.Retryer.RetryRules(req)
+
+	if r.maxBackoff > 0 && delay > r.maxBackoff {
+		delay = r.maxBackoff
+	}
+
+	return delay
+}
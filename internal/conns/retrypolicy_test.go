@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go"
+)
+
+func TestRetryPolicyRetryerV2IsErrorRetryable(t *testing.T) {
+	t.Parallel()
+
+	policy := &RetryPolicy{
+		ErrorCodes:    []string{"InvalidParameterValueException"},
+		ErrorMessages: []string{"role defined for the function cannot be assumed"},
+	}
+	retryer := policy.retryerV2(retry_sdkv2.NewStandard())
+
+	testCases := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"matching code": {
+			err:      &smithy.GenericAPIError{Code: "InvalidParameterValueException", Message: "some message"},
+			expected: true,
+		},
+		"matching message": {
+			err:      &smithy.GenericAPIError{Code: "AccessDeniedException", Message: "The role defined for the function cannot be assumed by Lambda."},
+			expected: true,
+		},
+		"wrapped": {
+			err:      fmt.Errorf("creating Lambda Function: %w", &smithy.GenericAPIError{Code: "InvalidParameterValueException"}),
+			expected: true,
+		},
+		"no match": {
+			err:      &smithy.GenericAPIError{Code: "ValidationException", Message: "some message"},
+			expected: false,
+		},
+		"not an API error": {
+			err:      errors.New("InvalidParameterValueException"),
+			expected: false,
+		},
+		"default retryable": {
+			err:      &smithy.GenericAPIError{Code: "ThrottlingException"},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := retryer.IsErrorRetryable(testCase.err), testCase.expected; got != want {
+				t.Errorf("IsErrorRetryable = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyRetryerV2MaxBackoff(t *testing.T) {
+	t.Parallel()
+
+	const maxBackoff = 2 * time.Second
+	policy := &RetryPolicy{
+		MaxBackoff: maxBackoff,
+	}
+
+	testCases := map[string]struct {
+		delay    time.Duration
+		expected time.Duration
+	}{
+		"below maximum": {
+			delay:    500 * time.Millisecond,
+			expected: 500 * time.Millisecond,
+		},
+		"at maximum": {
+			delay:    maxBackoff,
+			expected: maxBackoff,
+		},
+		"above maximum": {
+			delay:    30 * time.Second,
+			expected: maxBackoff,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			retryer := policy.retryerV2(&testRetryer{delay: testCase.delay})
+
+			delay, err := retryer.RetryDelay(1, &smithy.GenericAPIError{Code: "InternalError"})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := delay, testCase.expected; got != want {
+				t.Errorf("RetryDelay = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyRetryerV2MaxBackoffStandard(t *testing.T) {
+	t.Parallel()
+
+	const maxBackoff = 2 * time.Second
+	policy := &RetryPolicy{
+		MaxBackoff: maxBackoff,
+	}
+	retryer := policy.retryerV2(retry_sdkv2.NewStandard(func(o *retry_sdkv2.StandardOptions) {
+		o.Backoff = &v1CompatibleBackoff{maxRetryDelay: 300 * time.Second}
+	}))
+
+	for attempt := 1; attempt <= 20; attempt++ {
+		delay, err := retryer.RetryDelay(attempt, &smithy.GenericAPIError{Code: "InternalError"})
+		if err != nil {
+			t.Fatalf("attempt %d: unexpected error: %s", attempt, err)
+		}
+
+		if delay > maxBackoff {
+			t.Errorf("attempt %d: delay %s exceeds %s", attempt, delay, maxBackoff)
+		}
+	}
+}
+
+func TestRetryPolicyRetryerV2WrapsRetryer(t *testing.T) {
+	t.Parallel()
+
+	policy := &RetryPolicy{
+		ErrorCodes: []string{"InvalidParameterValueException"},
+	}
+	retryer := policy.retryerV2(&testRetryer{})
+
+	if _, err := retryer.GetAttemptToken(context.Background()); err != nil {
+		t.Errorf("GetAttemptToken: unexpected error: %s", err)
+	}
+
+	if !retryer.IsErrorRetryable(&smithy.GenericAPIError{Code: "InvalidParameterValueException"}) {
+		t.Errorf("IsErrorRetryable = false, want true")
+	}
+}
+
+func TestRetryPolicyAWSConfigV2(t *testing.T) {
+	t.Parallel()
+
+	cfg := &aws_sdkv2.Config{
+		RetryMaxAttempts: 25,
+		Retryer: func() aws_sdkv2.Retryer {
+			return &testRetryer{delay: 30 * time.Second} // Not a RetryerV2.
+		},
+	}
+	policy := &RetryPolicy{
+		MaxAttempts: 5,
+		MaxBackoff:  time.Second,
+	}
+
+	got := policy.awsConfigV2(cfg)
+
+	if got == cfg {
+		t.Fatal("expected a copy of the configuration")
+	}
+	if got, want := got.RetryMaxAttempts, 5; got != want {
+		t.Errorf("RetryMaxAttempts = %d, want %d", got, want)
+	}
+	if got, want := cfg.RetryMaxAttempts, 25; got != want {
+		t.Errorf("original RetryMaxAttempts = %d, want %d", got, want)
+	}
+
+	delay, err := got.Retryer().RetryDelay(1, errors.New("test"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := delay, time.Second; got != want {
+		t.Errorf("RetryDelay = %s, want %s", got, want)
+	}
+}
+
+func TestRetryPolicyRetryerV2NoOverrides(t *testing.T) {
+	t.Parallel()
+
+	var retryer aws_sdkv2.RetryerV2 = retry_sdkv2.NewStandard()
+
+	if got := (&RetryPolicy{}).retryerV2(retryer); got != retryer {
+		t.Errorf("expected Retryer to be unchanged")
+	}
+}
+
+// testRetryer is an AWS SDK for Go v2 Retryer that isn't a RetryerV2.
+type testRetryer struct {
+	delay time.Duration
+}
+
+func (r *testRetryer) IsErrorRetryable(error) bool {
+	return false
+}
+
+func (r *testRetryer) MaxAttempts() int {
+	return 3
+}
+
+func (r *testRetryer) RetryDelay(int, error) (time.Duration, error) {
+	return r.delay, nil
+}
+
+func (r *testRetryer) GetRetryToken(context.Context, error) (func(error) error, error) {
+	return func(error) error { return nil }, nil
+}
+
+func (r *testRetryer) GetInitialToken() func(error) error {
+	return func(error) error { return nil }
+}
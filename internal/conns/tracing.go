@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"sync"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
+)
+
+const (
+	tracingHandlerName = "TFAWSTracing"
+)
+
+// AWS SDK for Go v1 API call spans, keyed by request.
+var tracingSpansV1 sync.Map
+
+// tracingHandlersV1 returns AWS SDK for Go v1 request handlers that record a span for each API call.
+// The first handler starts the span and the second ends it.
+func tracingHandlersV1() (request_sdkv1.NamedHandler, request_sdkv1.NamedHandler) {
+	start := request_sdkv1.NamedHandler{
+		Name: tracingHandlerName,
+		Fn: func(r *request_sdkv1.Request) {
+			_, span := tracing.Start(r.Context(), r.ClientInfo.ServiceID+"."+r.Operation.Name, tracing.SpanKindClient,
+				tracing.String("rpc.system", "aws-api"),
+				tracing.String("rpc.service", r.ClientInfo.ServiceID),
+				tracing.String("rpc.method", r.Operation.Name),
+				tracing.String("cloud.region", aws_sdkv1.StringValue(r.Config.Region)),
+			)
+			tracingSpansV1.Store(r, span)
+		},
+	}
+	end := request_sdkv1.NamedHandler{
+		Name: tracingHandlerName,
+		Fn: func(r *request_sdkv1.Request) {
+			v, ok := tracingSpansV1.LoadAndDelete(r)
+			if !ok {
+				return
+			}
+			span := v.(*tracing.Span)
+
+			span.SetAttributes(
+				tracing.String("aws.request_id", r.RequestID),
+				tracing.Int("aws.retry_count", r.RetryCount),
+			)
+			if r.HTTPResponse != nil {
+				span.SetAttributes(tracing.Int("http.response.status_code", r.HTTPResponse.StatusCode))
+			}
+			if r.Error != nil {
+				span.SetError(r.Error.Error())
+			}
+			span.End()
+		},
+	}
+
+	return start, end
+}
+
+// addTracingMiddlewareV2 adds AWS SDK for Go v2 middleware that records a span for each API call.
+func addTracingMiddlewareV2(stack *middleware.Stack) error {
+	// Runs after the service metadata is registered.
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc(tracingHandlerName, func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		serviceID, operation := awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx)
+		ctx, span := tracing.Start(ctx, serviceID+"."+operation, tracing.SpanKindClient,
+			tracing.String("rpc.system", "aws-api"),
+			tracing.String("rpc.service", serviceID),
+			tracing.String("rpc.method", operation),
+			tracing.String("cloud.region", awsmiddleware.GetRegion(ctx)),
+		)
+		defer span.End()
+
+		out, metadata, err := next.HandleInitialize(ctx, in)
+
+		if v, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+			span.SetAttributes(tracing.String("aws.request_id", v))
+		}
+		if v, ok := retry_sdkv2.GetAttemptResults(metadata); ok && len(v.Results) > 0 {
+			span.SetAttributes(tracing.Int("aws.retry_count", len(v.Results)-1))
+		}
+		if v, ok := awsmiddleware.GetRawResponse(metadata).(*smithyhttp.Response); ok && v != nil {
+			span.SetAttributes(tracing.Int("http.response.status_code", v.StatusCode))
+		}
+		if err != nil {
+			span.SetError(err.Error())
+		}
+
+		return out, metadata, err
+	}), middleware.After)
+}
@@ -4,6 +4,7 @@
 package sync
 
 import (
+	"context"
 	"os"
 	"strconv"
 	"sync"
@@ -23,8 +24,12 @@ var semaphoreKV = &struct {
 	store: make(map[string]Semaphore),
 }
 
+// NewSemaphore returns a new semaphore with the specified capacity.
+func NewSemaphore(limit int) Semaphore {
+	return make(Semaphore, limit)
+}
+
 // GetSemaphore returns a named semaphore with a default capacity or overrides it using an environment variable
-// NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
 func GetSemaphore(key, envvar string, defaultLimit int) Semaphore {
 	semaphoreKV.lock.Lock()
 	defer semaphoreKV.lock.Unlock()
@@ -38,7 +43,7 @@ func GetSemaphore(key, envvar string, defaultLimit int) Semaphore {
 			}
 		}
 
-		semaphore = make(Semaphore, limit)
+		semaphore = NewSemaphore(limit)
 		semaphoreKV.store[key] = semaphore
 	}
 
@@ -46,13 +51,27 @@ func GetSemaphore(key, envvar string, defaultLimit int) Semaphore {
 }
 
 // Wait waits for a semaphore before continuing
-// NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
 func (s Semaphore) Wait() {
 	s <- struct{}{}
 }
 
+// WaitContext waits for a semaphore before continuing.
+// If the Context is done before the semaphore is acquired, the Context's error is returned and the semaphore is not acquired.
+func (s Semaphore) WaitContext(ctx context.Context) error {
+	// Don't acquire the semaphore if the Context is already done.
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	select {
+	case s <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // Notify releases a semaphore
-// NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
 func (s Semaphore) Notify() {
 	// Make the Notify non-blocking. This can happen if a Wait was never issued
 	select {
@@ -7,6 +7,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"os"
+	"slices"
+	"strconv"
 	"strings"
 
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
@@ -16,6 +19,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+const (
+	// IAMPolicyCatalogValidationEnvVar is the environment variable that enables validation of IAM policy
+	// actions and condition keys against the IAM catalog. Actions and condition keys that aren't in the
+	// catalog produce warnings.
+	IAMPolicyCatalogValidationEnvVar = "TF_AWS_IAM_POLICY_CATALOG_VALIDATION"
 )
 
 var (
@@ -170,5 +181,82 @@ func (v IAMPolicy) ValidateAttribute(ctx context.Context, req xattr.ValidateAttr
 				"Path: "+req.Path.String()+"\n"+
 				"Value: "+v.ValueString(),
 		)
+		return
+	}
+
+	if ok, _ := strconv.ParseBool(os.Getenv(IAMPolicyCatalogValidationEnvVar)); ok {
+		v.validateCatalog(req, resp)
+	}
+}
+
+// validateCatalog warns about actions and condition keys that aren't in the IAM catalog.
+func (v IAMPolicy) validateCatalog(req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	catalog, err := data.ReadIAMCatalog()
+	if err != nil {
+		resp.Diagnostics.AddError("Reading IAM Catalog", err.Error())
+		return
+	}
+
+	type statement struct {
+		Action    any
+		NotAction any
+		Condition map[string]map[string]any
+	}
+
+	var doc struct {
+		Statement json.RawMessage
+	}
+	if err := json.Unmarshal([]byte(v.ValueString()), &doc); err != nil {
+		return
+	}
+
+	// Statement is either a list of statements or a single statement.
+	var statements []statement
+	if err := json.Unmarshal(doc.Statement, &statements); err != nil {
+		var s statement
+		if err := json.Unmarshal(doc.Statement, &s); err != nil {
+			return
+		}
+		statements = []statement{s}
+	}
+
+	for _, s := range statements {
+		for _, action := range slices.Concat(iamPolicyStrings(s.Action), iamPolicyStrings(s.NotAction)) {
+			if err := catalog.CheckAction(action); err != nil {
+				resp.Diagnostics.AddAttributeWarning(req.Path, "Unknown IAM Policy Action", err.Error())
+			}
+		}
+
+		var keys []string
+		for _, condition := range s.Condition {
+			for key := range condition {
+				keys = append(keys, key)
+			}
+		}
+		slices.Sort(keys)
+
+		for _, key := range slices.Compact(keys) {
+			if err := catalog.CheckConditionKey(key); err != nil {
+				resp.Diagnostics.AddAttributeWarning(req.Path, "Unknown IAM Policy Condition Key", err.Error())
+			}
+		}
+	}
+}
+
+// iamPolicyStrings returns the values of a policy element that's a string or a list of strings.
+func iamPolicyStrings(v any) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		var values []string
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				values = append(values, v)
+			}
+		}
+		return values
+	default:
+		return nil
 	}
 }
@@ -52,6 +52,61 @@ func TestIAMPolicyValidateAttribute(t *testing.T) {
 	}
 }
 
+func TestIAMPolicyValidateAttributeCatalog(t *testing.T) { //nolint:paralleltest // Uses t.Setenv.
+	t.Setenv(fwtypes.IAMPolicyCatalogValidationEnvVar, "true")
+
+	type testCase struct {
+		val          fwtypes.IAMPolicy
+		wantWarnings int
+	}
+	tests := map[string]testCase{
+		"no statement": {
+			val: fwtypes.IAMPolicyValue(`{"Version": "2012-10-17"}`),
+		},
+		"known": {
+			val: fwtypes.IAMPolicyValue(`{
+  "Statement": [{
+    "Effect": "Allow",
+    "Action": ["sqs:SendMessage", "sqs:Receive*"],
+    "Resource": "*",
+    "Condition": {"StringEquals": {"aws:PrincipalTag/team": "a"}}
+  }]
+}`),
+		},
+		"unknown service": {
+			val: fwtypes.IAMPolicyValue(`{"Statement": {"Effect": "Allow", "Action": "nosuchservice:Anything", "Resource": "*"}}`),
+		},
+		"misspelled": {
+			val: fwtypes.IAMPolicyValue(`{
+  "Statement": {
+    "Effect": "Allow",
+    "NotAction": ["sqs:SendMessages", "sts:AssumeRole"],
+    "Resource": "*",
+    "Condition": {"StringEquals": {"aws:PrincipleTag/team": "a"}, "Bool": {"aws:PrincipleTag/team": "true"}}
+  }
+}`),
+			wantWarnings: 2,
+		},
+	}
+
+	for name, test := range tests { //nolint:paralleltest // Uses t.Setenv.
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			req := xattr.ValidateAttributeRequest{}
+			resp := xattr.ValidateAttributeResponse{}
+
+			test.val.ValidateAttribute(ctx, req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Errorf("unexpected errors: %v", resp.Diagnostics.Errors())
+			}
+			if got, want := resp.Diagnostics.WarningsCount(), test.wantWarnings; got != want {
+				t.Errorf("resp.Diagnostics.WarningsCount() = %d, want = %d: %v", got, want, resp.Diagnostics.Warnings())
+			}
+		})
+	}
+}
+
 func TestIAMPolicyStringSemanticEquals(t *testing.T) {
 	t.Parallel()
 
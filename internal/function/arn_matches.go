@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = arnMatchesFunction{}
+
+func NewARNMatchesFunction() function.Function {
+	return &arnMatchesFunction{}
+}
+
+type arnMatchesFunction struct{}
+
+func (f arnMatchesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_matches"
+}
+
+func (f arnMatchesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "arn_matches Function",
+		MarkdownDescription: "Checks whether an ARN matches an ARN pattern. Each section of the ARN is matched separately, " +
+			"with the `*` and `?` wildcards used in IAM policy Resource elements",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "pattern",
+				MarkdownDescription: "ARN pattern, or `*` to match any ARN",
+			},
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "ARN (Amazon Resource Name) to match",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f arnMatchesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var pattern, arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &pattern, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := arnMatches(pattern, arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// arnMatches returns whether an ARN matches an ARN pattern.
+// As in IAM policy Resource elements, wildcards do not match across the colons that separate the
+// partition, service, Region and account ID, but may match colons and slashes in the resource.
+func arnMatches(pattern, s string) (bool, error) {
+	a, err := arn.Parse(s)
+	if err != nil {
+		return false, err
+	}
+
+	if pattern == "*" {
+		return true, nil
+	}
+
+	p, err := arn.Parse(pattern)
+	if err != nil {
+		return false, fmt.Errorf("pattern: %w", err)
+	}
+
+	for _, v := range []struct {
+		pattern, s string
+	}{
+		{p.Partition, a.Partition},
+		{p.Service, a.Service},
+		{p.Region, a.Region},
+		{p.AccountID, a.AccountID},
+		{p.Resource, a.Resource},
+	} {
+		if !wildcardMatch(v.pattern, v.s) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// wildcardMatch returns whether a string matches a pattern in which `*` matches any sequence of characters,
+// including the empty sequence, and `?` matches any single character. Matching is case sensitive.
+func wildcardMatch(pattern, s string) bool {
+	p, r := []rune(pattern), []rune(s)
+
+	// Position of the most recent `*` in the pattern, and position in the string that it matches up to.
+	star, retry := -1, 0
+
+	i, j := 0, 0
+	for j < len(r) {
+		switch {
+		case i < len(p) && (p[i] == '?' || p[i] == r[j]):
+			i++
+			j++
+		case i < len(p) && p[i] == '*':
+			star, retry = i, j
+			i++
+		case star != -1:
+			// Backtrack, letting the most recent `*` match one more character.
+			retry++
+			i, j = star+1, retry
+		default:
+			return false
+		}
+	}
+
+	for i < len(p) && p[i] == '*' {
+		i++
+	}
+
+	return i == len(p)
+}
@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+)
+
+func TestARNMatchesFunction_match(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testARNMatchesFunctionConfig("arn:aws:s3:::corp-*-logs/*", "arn:aws:s3:::corp-prod-logs/2024/01"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestARNMatchesFunction_noMatch(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testARNMatchesFunctionConfig("arn:aws:iam::444455556666:role/app-?", "arn:aws:iam::444455556666:role/app-12"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestARNMatchesFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testARNMatchesFunctionConfig("*", "invalid"),
+				ExpectError: regexache.MustCompile(`invalid[\s\n]*prefix`),
+			},
+		},
+	})
+}
+
+func testARNMatchesFunctionConfig(pattern, arn string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::arn_matches(%[1]q, %[2]q)
+}
+`, pattern, arn)
+}
+
+func TestARNMatches(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		pattern       string
+		arn           string
+		expected      bool
+		expectedError string
+	}{
+		{
+			name:     "exact",
+			pattern:  "arn:aws:iam::444455556666:role/example", //lintignore:AWSAT005
+			arn:      "arn:aws:iam::444455556666:role/example", //lintignore:AWSAT005
+			expected: true,
+		},
+		{
+			name:     "any ARN",
+			pattern:  "*",
+			arn:      "arn:aws:iam::444455556666:role/example", //lintignore:AWSAT005
+			expected: true,
+		},
+		{
+			name:     "resource wildcards",
+			pattern:  "arn:aws:s3:::corp-*-logs/*",          //lintignore:AWSAT005
+			arn:      "arn:aws:s3:::corp-prod-logs/2024/01", //lintignore:AWSAT005
+			expected: true,
+		},
+		{
+			name:     "resource wildcard does not match",
+			pattern:  "arn:aws:s3:::corp-*-logs/*",  //lintignore:AWSAT005
+			arn:      "arn:aws:s3:::corp-prod-logs", //lintignore:AWSAT005
+			expected: false,
+		},
+		{
+			name:     "resource wildcard matches colons",
+			pattern:  "arn:aws:logs:us-west-2:444455556666:log-group:*",                    //lintignore:AWSAT003,AWSAT005
+			arn:      "arn:aws:logs:us-west-2:444455556666:log-group:example:log-stream:a", //lintignore:AWSAT003,AWSAT005
+			expected: true,
+		},
+		{
+			name:     "single character wildcard",
+			pattern:  "arn:aws:iam::444455556666:role/app-?", //lintignore:AWSAT005
+			arn:      "arn:aws:iam::444455556666:role/app-1", //lintignore:AWSAT005
+			expected: true,
+		},
+		{
+			name:     "single character wildcard does not match empty",
+			pattern:  "arn:aws:iam::444455556666:role/app-?", //lintignore:AWSAT005
+			arn:      "arn:aws:iam::444455556666:role/app-",  //lintignore:AWSAT005
+			expected: false,
+		},
+		{
+			name:     "single character wildcard does not match two characters",
+			pattern:  "arn:aws:iam::444455556666:role/app-?",  //lintignore:AWSAT005
+			arn:      "arn:aws:iam::444455556666:role/app-12", //lintignore:AWSAT005
+			expected: false,
+		},
+		{
+			name:     "partition wildcard",
+			pattern:  "arn:aws*:iam::444455556666:role/example",       //lintignore:AWSAT005
+			arn:      "arn:aws-us-gov:iam::444455556666:role/example", //lintignore:AWSAT005
+			expected: true,
+		},
+		{
+			name:     "Region and account wildcards",
+			pattern:  "arn:aws:sqs:*:*:queue",                    //lintignore:AWSAT005
+			arn:      "arn:aws:sqs:us-west-2:444455556666:queue", //lintignore:AWSAT003,AWSAT005
+			expected: true,
+		},
+		{
+			name:     "empty Region does not match non-empty",
+			pattern:  "arn:aws:sqs::444455556666:queue",          //lintignore:AWSAT005
+			arn:      "arn:aws:sqs:us-west-2:444455556666:queue", //lintignore:AWSAT003,AWSAT005
+			expected: false,
+		},
+		{
+			name:     "wildcard matches empty Region",
+			pattern:  "arn:aws:iam:*:444455556666:role/example", //lintignore:AWSAT005
+			arn:      "arn:aws:iam::444455556666:role/example",  //lintignore:AWSAT005
+			expected: true,
+		},
+		{
+			name:     "wildcard does not cross sections",
+			pattern:  "arn:aws:iam:::*",                        //lintignore:AWSAT005
+			arn:      "arn:aws:iam::444455556666:role/example", //lintignore:AWSAT005
+			expected: false,
+		},
+		{
+			name:     "service mismatch",
+			pattern:  "arn:aws:iam::444455556666:role/*",       //lintignore:AWSAT005
+			arn:      "arn:aws:sts::444455556666:role/example", //lintignore:AWSAT005
+			expected: false,
+		},
+		{
+			name:     "case sensitive",
+			pattern:  "arn:aws:iam::444455556666:role/Example", //lintignore:AWSAT005
+			arn:      "arn:aws:iam::444455556666:role/example", //lintignore:AWSAT005
+			expected: false,
+		},
+		{
+			name:     "multiple wildcards with backtracking",
+			pattern:  "arn:aws:s3:::*a*b?c*",   //lintignore:AWSAT005
+			arn:      "arn:aws:s3:::xaabxbzcd", //lintignore:AWSAT005
+			expected: true,
+		},
+		{
+			name:          "invalid ARN",
+			pattern:       "*",
+			arn:           "invalid",
+			expectedError: "arn: invalid prefix",
+		},
+		{
+			name:          "invalid pattern",
+			pattern:       "arn:aws:iam",
+			arn:           "arn:aws:iam::444455556666:role/example", //lintignore:AWSAT005
+			expectedError: "pattern: arn: not enough sections",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tffunction.ARNMatches(testCase.pattern, testCase.arn)
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error %q, got none", testCase.expectedError)
+				}
+				if got, want := err.Error(), testCase.expectedError; got != want {
+					t.Errorf("unexpected error: got %q, want %q", got, want)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("ARNMatches(%q, %q) = %t, want %t", testCase.pattern, testCase.arn, got, testCase.expected)
+			}
+		})
+	}
+}
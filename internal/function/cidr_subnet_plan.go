@@ -0,0 +1,349 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"cmp"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"math/bits"
+	"net/netip"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+const (
+	// Subnet size limits, see https://docs.aws.amazon.com/vpc/latest/userguide/subnet-sizing.html.
+	subnetMinIPv4PrefixLength = 16
+	subnetMaxIPv4PrefixLength = 28
+	subnetIPv6PrefixLength    = 64
+
+	// The first four and the last IPv4 address in each subnet are reserved.
+	subnetReservedIPv4Addresses = 5
+)
+
+var cidrSubnetPlanSubnetAttrTypes = map[string]attr.Type{
+	"cidr_block":      types.StringType,
+	"ipv6_cidr_block": types.StringType,
+}
+
+var _ function.Function = cidrSubnetPlanFunction{}
+
+func NewCIDRSubnetPlanFunction() function.Function {
+	return &cidrSubnetPlanFunction{}
+}
+
+type cidrSubnetPlanFunction struct{}
+
+func (f cidrSubnetPlanFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_subnet_plan"
+}
+
+func (f cidrSubnetPlanFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_subnet_plan Function",
+		MarkdownDescription: "Allocates non-overlapping subnets for each tier in each Availability Zone of a VPC. " +
+			"Optionally allocates an IPv6 /64 to each subnet.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "vpc_cidr_block",
+				MarkdownDescription: "IPv4 CIDR block of the VPC",
+			},
+			function.DynamicParameter{
+				Name: "tiers",
+				MarkdownDescription: "List of tiers. Each tier is an object with a `name` and either a `prefix_length` " +
+					"or the number of `hosts` each subnet must hold",
+			},
+			function.Int64Parameter{
+				Name:                "az_count",
+				MarkdownDescription: "Number of Availability Zones",
+			},
+			function.StringParameter{
+				AllowNullValue:      true,
+				Name:                "ipv6_cidr_block",
+				MarkdownDescription: "IPv6 CIDR block of the VPC, or `null`",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.ListType{
+				ElemType: types.ObjectType{
+					AttrTypes: cidrSubnetPlanSubnetAttrTypes,
+				},
+			},
+		},
+	}
+}
+
+func (f cidrSubnetPlanFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var vpcCIDRBlock string
+	var tiersArg types.Dynamic
+	var azCount int64
+	var ipv6CIDRBlock types.String
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &vpcCIDRBlock, &tiersArg, &azCount, &ipv6CIDRBlock))
+	if resp.Error != nil {
+		return
+	}
+
+	tiers, err := expandSubnetPlanTiers(tiersArg.UnderlyingValue())
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	plan, err := planSubnets(vpcCIDRBlock, tiers, int(azCount), ipv6CIDRBlock.ValueString())
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	elemType := types.ObjectType{AttrTypes: cidrSubnetPlanSubnetAttrTypes}
+	value := make(map[string]attr.Value, len(plan))
+	for tier, subnets := range plan {
+		elems := make([]attr.Value, 0, len(subnets))
+		for _, subnet := range subnets {
+			ipv6CIDRBlock := types.StringNull()
+			if subnet.IPv6CIDRBlock != "" {
+				ipv6CIDRBlock = types.StringValue(subnet.IPv6CIDRBlock)
+			}
+
+			elem, d := types.ObjectValue(cidrSubnetPlanSubnetAttrTypes, map[string]attr.Value{
+				"cidr_block":      types.StringValue(subnet.CIDRBlock),
+				"ipv6_cidr_block": ipv6CIDRBlock,
+			})
+			if d.HasError() {
+				resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+				return
+			}
+			elems = append(elems, elem)
+		}
+
+		list, d := types.ListValue(elemType, elems)
+		if d.HasError() {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+			return
+		}
+		value[tier] = list
+	}
+
+	result, d := types.MapValue(types.ListType{ElemType: elemType}, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+type subnetPlanTier struct {
+	Name         string
+	PrefixLength int // Zero if Hosts is set.
+	Hosts        int
+}
+
+type subnetPlanSubnet struct {
+	CIDRBlock     string
+	IPv6CIDRBlock string
+}
+
+// expandSubnetPlanTiers expands a list or tuple of tier objects.
+func expandSubnetPlanTiers(v attr.Value) ([]subnetPlanTier, error) {
+	var elems []attr.Value
+
+	switch v := v.(type) {
+	case basetypes.ListValue:
+		elems = v.Elements()
+	case basetypes.TupleValue:
+		elems = v.Elements()
+	default:
+		return nil, errors.New("tiers must be a list of objects")
+	}
+
+	tiers := make([]subnetPlanTier, 0, len(elems))
+	for i, elem := range elems {
+		obj, ok := elem.(basetypes.ObjectValue)
+		if !ok || obj.IsNull() {
+			return nil, fmt.Errorf("tier %d: must be an object", i)
+		}
+
+		var tier subnetPlanTier
+		for k, v := range obj.Attributes() {
+			if v.IsNull() {
+				continue
+			}
+
+			switch k {
+			case "name":
+				v, ok := v.(basetypes.StringValue)
+				if !ok {
+					return nil, fmt.Errorf("tier %d: name must be a string", i)
+				}
+				tier.Name = v.ValueString()
+			case "prefix_length", "hosts":
+				v, ok := v.(basetypes.NumberValue)
+				if !ok {
+					return nil, fmt.Errorf("tier %d: %s must be a number", i, k)
+				}
+				n, accuracy := v.ValueBigFloat().Int64()
+				if accuracy != big.Exact || n < 1 {
+					return nil, fmt.Errorf("tier %d: %s must be a positive whole number", i, k)
+				}
+				if k == "prefix_length" {
+					tier.PrefixLength = int(n)
+				} else {
+					tier.Hosts = int(n)
+				}
+			default:
+				return nil, fmt.Errorf("tier %d: unsupported attribute %q", i, k)
+			}
+		}
+
+		tiers = append(tiers, tier)
+	}
+
+	return tiers, nil
+}
+
+// planSubnets allocates an IPv4 subnet for each tier in each Availability Zone from the VPC's IPv4 CIDR block.
+// Subnets are allocated largest first, so that no address space is wasted on alignment.
+// If an IPv6 CIDR block is specified, each subnet is also allocated an IPv6 /64 in tier order.
+func planSubnets(vpcCIDRBlock string, tiers []subnetPlanTier, azCount int, ipv6CIDRBlock string) (map[string][]subnetPlanSubnet, error) {
+	if err := itypes.ValidateCIDRBlock(vpcCIDRBlock); err != nil {
+		return nil, err
+	}
+	vpc, err := netip.ParsePrefix(vpcCIDRBlock)
+	if err != nil {
+		return nil, err
+	}
+	if !vpc.Addr().Is4() {
+		return nil, fmt.Errorf("%q is not an IPv4 CIDR block", vpcCIDRBlock)
+	}
+
+	if azCount < 1 || azCount > 1<<16 {
+		return nil, fmt.Errorf("az_count (%d) must be between 1 and %d", azCount, 1<<16)
+	}
+	if len(tiers) == 0 {
+		return nil, errors.New("at least one tier is required")
+	}
+
+	prefixLengths := make([]int, len(tiers))
+	names := make(map[string]struct{}, len(tiers))
+	for i, tier := range tiers {
+		if tier.Name == "" {
+			return nil, fmt.Errorf("tier %d: name is required", i)
+		}
+		if _, ok := names[tier.Name]; ok {
+			return nil, fmt.Errorf("tier %d: duplicate name %q", i, tier.Name)
+		}
+		names[tier.Name] = struct{}{}
+
+		prefixLength, err := subnetPlanTierPrefixLength(tier)
+		if err != nil {
+			return nil, fmt.Errorf("tier %q: %w", tier.Name, err)
+		}
+		if prefixLength < vpc.Bits() {
+			return nil, fmt.Errorf("tier %q: a /%d subnet does not fit in VPC CIDR block %s", tier.Name, prefixLength, vpc)
+		}
+		prefixLengths[i] = prefixLength
+	}
+
+	// Allocating in descending order of size keeps every subnet aligned without gaps,
+	// so the allocation fits if and only if the total size fits.
+	var total, capacity uint64 = 0, 1 << (32 - vpc.Bits())
+	for _, prefixLength := range prefixLengths {
+		total += uint64(azCount) << (32 - prefixLength)
+	}
+	if total > capacity {
+		return nil, fmt.Errorf("address space exhausted: %d subnets need %d IPv4 addresses, but VPC CIDR block %s has %d", len(tiers)*azCount, total, vpc, capacity)
+	}
+
+	order := make([]int, len(tiers))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return cmp.Compare(prefixLengths[a], prefixLengths[b])
+	})
+
+	plan := make(map[string][]subnetPlanSubnet, len(tiers))
+	for _, tier := range tiers {
+		plan[tier.Name] = make([]subnetPlanSubnet, azCount)
+	}
+
+	base := vpc.Addr().As4()
+	next := uint64(binary.BigEndian.Uint32(base[:]))
+	for _, i := range order {
+		for az := 0; az < azCount; az++ {
+			var addr [4]byte
+			binary.BigEndian.PutUint32(addr[:], uint32(next))
+			plan[tiers[i].Name][az].CIDRBlock = netip.PrefixFrom(netip.AddrFrom4(addr), prefixLengths[i]).String()
+			next += 1 << (32 - prefixLengths[i])
+		}
+	}
+
+	if ipv6CIDRBlock != "" {
+		if err := itypes.ValidateCIDRBlock(ipv6CIDRBlock); err != nil {
+			return nil, err
+		}
+		vpc, err := netip.ParsePrefix(ipv6CIDRBlock)
+		if err != nil {
+			return nil, err
+		}
+		if !vpc.Addr().Is6() || vpc.Addr().Is4In6() {
+			return nil, fmt.Errorf("%q is not an IPv6 CIDR block", ipv6CIDRBlock)
+		}
+		if vpc.Bits() > subnetIPv6PrefixLength {
+			return nil, fmt.Errorf("IPv6 CIDR block %s is smaller than a /%d", vpc, subnetIPv6PrefixLength)
+		}
+
+		// A /0 holds more /64s than can be counted in a uint64.
+		if n := subnetIPv6PrefixLength - vpc.Bits(); n < 64 {
+			if count, capacity := uint64(len(tiers)*azCount), uint64(1)<<n; count > capacity {
+				return nil, fmt.Errorf("address space exhausted: %d subnets need an IPv6 /%d each, but IPv6 CIDR block %s holds only %d", count, subnetIPv6PrefixLength, vpc, capacity)
+			}
+		}
+
+		base := vpc.Addr().As16()
+		next := binary.BigEndian.Uint64(base[:8])
+		for _, tier := range tiers {
+			for az := 0; az < azCount; az++ {
+				var addr [16]byte
+				binary.BigEndian.PutUint64(addr[:8], next)
+				plan[tier.Name][az].IPv6CIDRBlock = netip.PrefixFrom(netip.AddrFrom16(addr), subnetIPv6PrefixLength).String()
+				next++
+			}
+		}
+	}
+
+	return plan, nil
+}
+
+// subnetPlanTierPrefixLength returns the IPv4 prefix length of each of a tier's subnets.
+func subnetPlanTierPrefixLength(tier subnetPlanTier) (int, error) {
+	switch {
+	case tier.PrefixLength != 0 && tier.Hosts != 0:
+		return 0, errors.New("only one of prefix_length or hosts can be specified")
+	case tier.PrefixLength != 0:
+		if tier.PrefixLength < subnetMinIPv4PrefixLength || tier.PrefixLength > subnetMaxIPv4PrefixLength {
+			return 0, fmt.Errorf("prefix_length (%d) must be between %d and %d", tier.PrefixLength, subnetMinIPv4PrefixLength, subnetMaxIPv4PrefixLength)
+		}
+		return tier.PrefixLength, nil
+	case tier.Hosts != 0:
+		prefixLength := min(32-bits.Len(uint(tier.Hosts+subnetReservedIPv4Addresses-1)), subnetMaxIPv4PrefixLength)
+		if prefixLength < subnetMinIPv4PrefixLength {
+			return 0, fmt.Errorf("hosts (%d) exceeds the size of the largest subnet, a /%d", tier.Hosts, subnetMinIPv4PrefixLength)
+		}
+		return prefixLength, nil
+	default:
+		return 0, errors.New("one of prefix_length or hosts must be specified")
+	}
+}
@@ -0,0 +1,302 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+)
+
+func TestCIDRSubnetPlanFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetPlanFunctionConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("public", "10.0.12.0/24,10.0.13.0/24,10.0.14.0/24"),
+					resource.TestCheckOutput("private", "10.0.0.0/22,10.0.4.0/22,10.0.8.0/22"),
+					resource.TestCheckOutput("ipv6", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetPlanFunction_dualStack(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetPlanFunctionConfig_dualStack(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("public", "10.0.0.0/24,10.0.1.0/24"),
+					resource.TestCheckOutput("public_ipv6", "2600:1f14:abc:de00::/64,2600:1f14:abc:de01::/64"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetPlanFunction_exhausted(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetPlanFunctionConfig_exhausted(),
+				ExpectError: regexache.MustCompile(`address[\s\n]*space[\s\n]*exhausted`),
+			},
+		},
+	})
+}
+
+func testCIDRSubnetPlanFunctionConfig_basic() string {
+	return `
+locals {
+  plan = provider::aws::cidr_subnet_plan("10.0.0.0/16", [
+    { name = "public", prefix_length = 24 },
+    { name = "private", hosts = 1000 },
+  ], 3, null)
+}
+
+output "public" {
+  value = join(",", local.plan["public"][*].cidr_block)
+}
+
+output "private" {
+  value = join(",", local.plan["private"][*].cidr_block)
+}
+
+output "ipv6" {
+  value = anytrue([for v in local.plan["public"] : v.ipv6_cidr_block != null])
+}
+`
+}
+
+func testCIDRSubnetPlanFunctionConfig_dualStack() string {
+	return `
+locals {
+  plan = provider::aws::cidr_subnet_plan("10.0.0.0/16", [
+    { name = "public", prefix_length = 24 },
+  ], 2, "2600:1f14:abc:de00::/56")
+}
+
+output "public" {
+  value = join(",", local.plan["public"][*].cidr_block)
+}
+
+output "public_ipv6" {
+  value = join(",", local.plan["public"][*].ipv6_cidr_block)
+}
+`
+}
+
+func testCIDRSubnetPlanFunctionConfig_exhausted() string {
+	return `
+output "test" {
+  value = provider::aws::cidr_subnet_plan("10.0.0.0/24", [
+    { name = "public", prefix_length = 25 },
+  ], 3, null)
+}
+`
+}
+
+func TestPlanSubnets(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		vpcCIDRBlock  string
+		tiers         []tffunction.SubnetPlanTier
+		azCount       int
+		ipv6CIDRBlock string
+		expected      map[string][]tffunction.SubnetPlanSubnet
+		expectedError string
+	}{
+		{
+			name:         "largest first",
+			vpcCIDRBlock: "10.0.0.0/16",
+			tiers: []tffunction.SubnetPlanTier{
+				{Name: "public", PrefixLength: 24},
+				{Name: "private", Hosts: 1000},
+				{Name: "database", Hosts: 11},
+			},
+			azCount: 3,
+			expected: map[string][]tffunction.SubnetPlanSubnet{
+				"public": {
+					{CIDRBlock: "10.0.12.0/24"},
+					{CIDRBlock: "10.0.13.0/24"},
+					{CIDRBlock: "10.0.14.0/24"},
+				},
+				"private": {
+					{CIDRBlock: "10.0.0.0/22"},
+					{CIDRBlock: "10.0.4.0/22"},
+					{CIDRBlock: "10.0.8.0/22"},
+				},
+				"database": {
+					{CIDRBlock: "10.0.15.0/28"},
+					{CIDRBlock: "10.0.15.16/28"},
+					{CIDRBlock: "10.0.15.32/28"},
+				},
+			},
+		},
+		{
+			name:         "dual-stack",
+			vpcCIDRBlock: "10.0.0.0/16",
+			tiers: []tffunction.SubnetPlanTier{
+				{Name: "public", PrefixLength: 24},
+				{Name: "private", PrefixLength: 20},
+			},
+			azCount:       2,
+			ipv6CIDRBlock: "2600:1f14:abc:de00::/56",
+			expected: map[string][]tffunction.SubnetPlanSubnet{
+				"public": {
+					{CIDRBlock: "10.0.32.0/24", IPv6CIDRBlock: "2600:1f14:abc:de00::/64"},
+					{CIDRBlock: "10.0.33.0/24", IPv6CIDRBlock: "2600:1f14:abc:de01::/64"},
+				},
+				"private": {
+					{CIDRBlock: "10.0.0.0/20", IPv6CIDRBlock: "2600:1f14:abc:de02::/64"},
+					{CIDRBlock: "10.0.16.0/20", IPv6CIDRBlock: "2600:1f14:abc:de03::/64"},
+				},
+			},
+		},
+		{
+			name:         "exactly full",
+			vpcCIDRBlock: "10.0.0.0/24",
+			tiers: []tffunction.SubnetPlanTier{
+				{Name: "a", PrefixLength: 25},
+			},
+			azCount: 2,
+			expected: map[string][]tffunction.SubnetPlanSubnet{
+				"a": {
+					{CIDRBlock: "10.0.0.0/25"},
+					{CIDRBlock: "10.0.0.128/25"},
+				},
+			},
+		},
+		{
+			name:         "IPv4 exhausted",
+			vpcCIDRBlock: "10.0.0.0/24",
+			tiers: []tffunction.SubnetPlanTier{
+				{Name: "a", PrefixLength: 25},
+			},
+			azCount:       3,
+			expectedError: "address space exhausted: 3 subnets need 384 IPv4 addresses, but VPC CIDR block 10.0.0.0/24 has 256",
+		},
+		{
+			name:         "IPv6 exhausted",
+			vpcCIDRBlock: "10.0.0.0/24",
+			tiers: []tffunction.SubnetPlanTier{
+				{Name: "a", Hosts: 12},
+			},
+			azCount:       2,
+			ipv6CIDRBlock: "2600:1f14:abc:de00::/64",
+			expectedError: "address space exhausted: 2 subnets need an IPv6 /64 each, but IPv6 CIDR block 2600:1f14:abc:de00::/64 holds only 1",
+		},
+		{
+			name:         "subnet larger than VPC",
+			vpcCIDRBlock: "10.0.0.0/24",
+			tiers: []tffunction.SubnetPlanTier{
+				{Name: "a", PrefixLength: 20},
+			},
+			azCount:       1,
+			expectedError: `tier "a": a /20 subnet does not fit in VPC CIDR block 10.0.0.0/24`,
+		},
+		{
+			name:         "too many hosts",
+			vpcCIDRBlock: "10.0.0.0/16",
+			tiers: []tffunction.SubnetPlanTier{
+				{Name: "a", Hosts: 100000},
+			},
+			azCount:       1,
+			expectedError: `tier "a": hosts (100000) exceeds the size of the largest subnet, a /16`,
+		},
+		{
+			name:         "prefix length and hosts",
+			vpcCIDRBlock: "10.0.0.0/16",
+			tiers: []tffunction.SubnetPlanTier{
+				{Name: "a", PrefixLength: 24, Hosts: 10},
+			},
+			azCount:       1,
+			expectedError: `tier "a": only one of prefix_length or hosts can be specified`,
+		},
+		{
+			name:         "duplicate name",
+			vpcCIDRBlock: "10.0.0.0/16",
+			tiers: []tffunction.SubnetPlanTier{
+				{Name: "a", PrefixLength: 24},
+				{Name: "a", PrefixLength: 24},
+			},
+			azCount:       1,
+			expectedError: `tier 1: duplicate name "a"`,
+		},
+		{
+			name:         "invalid VPC CIDR block",
+			vpcCIDRBlock: "10.0.0.1/16",
+			tiers: []tffunction.SubnetPlanTier{
+				{Name: "a", PrefixLength: 24},
+			},
+			azCount:       1,
+			expectedError: `"10.0.0.1/16" is not a valid CIDR block; did you mean "10.0.0.0/16"?`,
+		},
+		{
+			name:         "IPv6 VPC CIDR block",
+			vpcCIDRBlock: "2600:1f14:abc:de00::/56",
+			tiers: []tffunction.SubnetPlanTier{
+				{Name: "a", PrefixLength: 24},
+			},
+			azCount:       1,
+			expectedError: `"2600:1f14:abc:de00::/56" is not an IPv4 CIDR block`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tffunction.PlanSubnets(testCase.vpcCIDRBlock, testCase.tiers, testCase.azCount, testCase.ipv6CIDRBlock)
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error %q, got none", testCase.expectedError)
+				}
+				if got, want := err.Error(), testCase.expectedError; got != want {
+					t.Errorf("unexpected error: got %q, want %q", got, want)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
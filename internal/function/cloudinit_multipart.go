@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// A fixed boundary keeps the result stable between plans.
+	cloudInitMultipartBoundary = "MIMEBOUNDARY"
+)
+
+var _ function.Function = cloudInitMultipartFunction{}
+
+func NewCloudInitMultipartFunction() function.Function {
+	return &cloudInitMultipartFunction{}
+}
+
+type cloudInitMultipartFunction struct{}
+
+func (f cloudInitMultipartFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cloudinit_multipart"
+}
+
+func (f cloudInitMultipartFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cloudinit_multipart Function",
+		MarkdownDescription: "Builds MIME multipart/mixed cloud-init user data for EC2 instances from an ordered list of parts. " +
+			"Returns an error if the user data exceeds the EC2 limit of 16 KB",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name: "parts",
+				MarkdownDescription: "List of parts. Each part is an object with a `content_type`, `content` and optionally " +
+					"a `filename`",
+			},
+			function.BoolParameter{
+				Name:                "gzip",
+				MarkdownDescription: "Whether to compress the user data with gzip. Requires `base64_encode`",
+			},
+			function.BoolParameter{
+				Name:                "base64_encode",
+				MarkdownDescription: "Whether to base64-encode the user data, e.g. for `user_data_base64`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f cloudInitMultipartFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var partsArg types.Dynamic
+	var compress, base64Encode bool
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &partsArg, &compress, &base64Encode))
+	if resp.Error != nil {
+		return
+	}
+
+	parts, err := expandCloudInitParts(partsArg.UnderlyingValue())
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	result, err := cloudInitMultipart(parts, compress, base64Encode)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+type cloudInitPart struct {
+	ContentType string
+	Filename    string
+	Content     string
+}
+
+// expandCloudInitParts expands a list or tuple of part objects.
+func expandCloudInitParts(v attr.Value) ([]cloudInitPart, error) {
+	var elems []attr.Value
+
+	switch v := v.(type) {
+	case basetypes.ListValue:
+		elems = v.Elements()
+	case basetypes.TupleValue:
+		elems = v.Elements()
+	default:
+		return nil, errors.New("parts must be a list of objects")
+	}
+
+	parts := make([]cloudInitPart, 0, len(elems))
+	for i, elem := range elems {
+		obj, ok := elem.(basetypes.ObjectValue)
+		if !ok || obj.IsNull() {
+			return nil, fmt.Errorf("part %d: must be an object", i)
+		}
+
+		var part cloudInitPart
+		for k, v := range obj.Attributes() {
+			if v.IsNull() {
+				continue
+			}
+
+			s, ok := v.(basetypes.StringValue)
+			if !ok {
+				return nil, fmt.Errorf("part %d: %s must be a string", i, k)
+			}
+
+			switch k {
+			case "content_type":
+				part.ContentType = s.ValueString()
+			case "filename":
+				part.Filename = s.ValueString()
+			case "content":
+				part.Content = s.ValueString()
+			default:
+				return nil, fmt.Errorf("part %d: unsupported attribute %q", i, k)
+			}
+		}
+
+		parts = append(parts, part)
+	}
+
+	return parts, nil
+}
+
+// cloudInitMultipart returns MIME multipart/mixed user data containing the specified parts, in order.
+// The result is gzip-compressed and base64-encoded if requested.
+func cloudInitMultipart(parts []cloudInitPart, compress, base64Encode bool) (string, error) {
+	if len(parts) == 0 {
+		return "", errors.New("at least one part is required")
+	}
+	if compress && !base64Encode {
+		return "", errors.New("gzip requires base64_encode, as compressed user data is not valid UTF-8")
+	}
+
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=%q\r\nMIME-Version: 1.0\r\n\r\n", cloudInitMultipartBoundary)
+
+	w := multipart.NewWriter(&buf)
+	if err := w.SetBoundary(cloudInitMultipartBoundary); err != nil {
+		return "", err
+	}
+
+	for i, part := range parts {
+		if part.ContentType == "" {
+			return "", fmt.Errorf("part %d: content_type is required", i)
+		}
+		if _, _, err := mime.ParseMediaType(part.ContentType); err != nil {
+			return "", fmt.Errorf("part %d: content_type (%s): %w", i, part.ContentType, err)
+		}
+		if strings.Contains(part.Content, "--"+cloudInitMultipartBoundary) {
+			return "", fmt.Errorf("part %d: content must not contain the MIME boundary (--%s)", i, cloudInitMultipartBoundary)
+		}
+
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", part.ContentType)
+		header.Set("MIME-Version", "1.0")
+		if part.Filename != "" {
+			header.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": part.Filename}))
+		}
+
+		pw, err := w.CreatePart(header)
+		if err != nil {
+			return "", err
+		}
+		if _, err := pw.Write([]byte(part.Content)); err != nil {
+			return "", err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+
+	userData := buf.Bytes()
+
+	if compress {
+		var buf bytes.Buffer
+
+		zw := gzip.NewWriter(&buf)
+		if _, err := zw.Write(userData); err != nil {
+			return "", err
+		}
+		if err := zw.Close(); err != nil {
+			return "", err
+		}
+
+		userData = buf.Bytes()
+	}
+
+	if n := len(userData); n > names.EC2UserDataMaxLength {
+		if !compress {
+			return "", fmt.Errorf("user data is %d bytes, which exceeds the EC2 limit of %d bytes; consider enabling gzip", n, names.EC2UserDataMaxLength)
+		}
+		return "", fmt.Errorf("user data is %d bytes, which exceeds the EC2 limit of %d bytes", n, names.EC2UserDataMaxLength)
+	}
+
+	if base64Encode {
+		return itypes.Base64Encode(userData), nil
+	}
+
+	return string(userData), nil
+}
@@ -0,0 +1,267 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+func TestCloudInitMultipartFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCloudInitMultipartFunctionConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Content-Type: multipart/mixed; boundary=\"MIMEBOUNDARY\"\r\nMIME-Version: 1.0\r\n\r\n--MIMEBOUNDARY\r\nContent-Disposition: attachment; filename=cloud.cfg\r\nContent-Type: text/cloud-config\r\nMime-Version: 1.0\r\n\r\n#cloud-config\n\r\n--MIMEBOUNDARY--\r\n"),
+				),
+			},
+		},
+	})
+}
+
+func TestCloudInitMultipartFunction_gzip(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCloudInitMultipartFunctionConfig_gzip(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchOutput("test", regexache.MustCompile(`^H4sI`)),
+				),
+			},
+		},
+	})
+}
+
+func TestCloudInitMultipartFunction_tooLarge(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCloudInitMultipartFunctionConfig_tooLarge(),
+				ExpectError: regexache.MustCompile(`exceeds[\s\n]*the[\s\n]*EC2[\s\n]*limit`),
+			},
+		},
+	})
+}
+
+func testCloudInitMultipartFunctionConfig_basic() string {
+	return `
+output "test" {
+  value = provider::aws::cloudinit_multipart([
+    {
+      content_type = "text/cloud-config"
+      filename     = "cloud.cfg"
+      content      = "#cloud-config\n"
+    },
+  ], false, false)
+}
+`
+}
+
+func testCloudInitMultipartFunctionConfig_gzip() string {
+	return `
+output "test" {
+  value = provider::aws::cloudinit_multipart([
+    {
+      content_type = "text/x-shellscript"
+      content      = "#!/bin/bash\necho hello\n"
+    },
+  ], true, true)
+}
+`
+}
+
+func testCloudInitMultipartFunctionConfig_tooLarge() string {
+	return `
+output "test" {
+  value = provider::aws::cloudinit_multipart([
+    {
+      content_type = "text/x-shellscript"
+      content      = join("\n", [for i in range(2000) : "echo hello"])
+    },
+  ], false, false)
+}
+`
+}
+
+func TestCloudInitMultipart(t *testing.T) {
+	t.Parallel()
+
+	parts := []tffunction.CloudInitPart{
+		{
+			ContentType: "text/cloud-config",
+			Filename:    "cloud.cfg",
+			Content:     "#cloud-config\npackages: [nginx]\n",
+		},
+		{
+			ContentType: "text/x-shellscript",
+			Content:     "#!/bin/bash\necho hello\n",
+		},
+	}
+	expected := "Content-Type: multipart/mixed; boundary=\"MIMEBOUNDARY\"\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"\r\n" +
+		"--MIMEBOUNDARY\r\n" +
+		"Content-Disposition: attachment; filename=cloud.cfg\r\n" +
+		"Content-Type: text/cloud-config\r\n" +
+		"Mime-Version: 1.0\r\n" +
+		"\r\n" +
+		"#cloud-config\npackages: [nginx]\n" +
+		"\r\n--MIMEBOUNDARY\r\n" +
+		"Content-Type: text/x-shellscript\r\n" +
+		"Mime-Version: 1.0\r\n" +
+		"\r\n" +
+		"#!/bin/bash\necho hello\n" +
+		"\r\n--MIMEBOUNDARY--\r\n"
+
+	testCases := []struct {
+		name          string
+		parts         []tffunction.CloudInitPart
+		gzip          bool
+		base64Encode  bool
+		expected      string
+		expectedError string
+	}{
+		{
+			name:     "plain",
+			parts:    parts,
+			expected: expected,
+		},
+		{
+			name:         "base64",
+			parts:        parts,
+			base64Encode: true,
+			expected:     itypes.Base64Encode([]byte(expected)),
+		},
+		{
+			name:         "gzip",
+			parts:        parts,
+			gzip:         true,
+			base64Encode: true,
+			expected:     expected,
+		},
+		{
+			name: "too large",
+			parts: []tffunction.CloudInitPart{
+				{
+					ContentType: "text/x-shellscript",
+					Content:     strings.Repeat("echo hello\n", 2000),
+				},
+			},
+			expectedError: "user data is 22168 bytes, which exceeds the EC2 limit of 16384 bytes; consider enabling gzip",
+		},
+		{
+			name: "too large before compression",
+			parts: []tffunction.CloudInitPart{
+				{
+					ContentType: "text/x-shellscript",
+					Content:     strings.Repeat("echo hello\n", 2000),
+				},
+			},
+			gzip:         true,
+			base64Encode: true,
+		},
+		{
+			name:          "gzip without base64",
+			parts:         parts,
+			gzip:          true,
+			expectedError: "gzip requires base64_encode, as compressed user data is not valid UTF-8",
+		},
+		{
+			name:          "no parts",
+			expectedError: "at least one part is required",
+		},
+		{
+			name: "missing content type",
+			parts: []tffunction.CloudInitPart{
+				{
+					Content: "#!/bin/bash\n",
+				},
+			},
+			expectedError: "part 0: content_type is required",
+		},
+		{
+			name: "content contains boundary",
+			parts: []tffunction.CloudInitPart{
+				{
+					ContentType: "text/x-shellscript",
+					Content:     "#!/bin/bash\necho --MIMEBOUNDARY\n",
+				},
+			},
+			expectedError: "part 0: content must not contain the MIME boundary (--MIMEBOUNDARY)",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tffunction.CloudInitMultipart(testCase.parts, testCase.gzip, testCase.base64Encode)
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error %q, got none", testCase.expectedError)
+				}
+				if got, want := err.Error(), testCase.expectedError; got != want {
+					t.Errorf("unexpected error: got %q, want %q", got, want)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.gzip {
+				b, err := itypes.Base64Decode(got)
+				if err != nil {
+					t.Fatalf("decoding base64: %s", err)
+				}
+				r, err := gzip.NewReader(bytes.NewReader(b))
+				if err != nil {
+					t.Fatalf("decompressing: %s", err)
+				}
+				b, err = io.ReadAll(r)
+				if err != nil {
+					t.Fatalf("decompressing: %s", err)
+				}
+				got = string(b)
+			}
+
+			if testCase.expected != "" && got != testCase.expected {
+				t.Errorf("unexpected user data: got %q, want %q", got, testCase.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestDurationParseFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testDurationParseFunctionConfig("P1DT12H30M"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("days", "1"),
+					resource.TestCheckOutput("hours", "12"),
+					resource.TestCheckOutput("minutes", "30"),
+					resource.TestCheckOutput("total_seconds", "131400"),
+				),
+			},
+		},
+	})
+}
+
+func TestDurationParseFunction_weeks(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testDurationParseFunctionConfig("P2W"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("days", "0"),
+					resource.TestCheckOutput("hours", "0"),
+					resource.TestCheckOutput("minutes", "0"),
+					resource.TestCheckOutput("total_seconds", "1209600"),
+				),
+			},
+		},
+	})
+}
+
+func TestDurationParseFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testDurationParseFunctionConfig("1h"),
+				ExpectError: regexache.MustCompile("invalid syntax"),
+			},
+		},
+	})
+}
+
+func testDurationParseFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+locals {
+  duration = provider::aws::duration_parse(%[1]q)
+}
+
+output "days" {
+  value = local.duration.days
+}
+
+output "hours" {
+  value = local.duration.hours
+}
+
+output "minutes" {
+  value = local.duration.minutes
+}
+
+output "total_seconds" {
+  value = local.duration.total_seconds
+}
+`, arg)
+}
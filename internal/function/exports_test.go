@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+// Exports for use in tests only.
+const (
+	S3URITypeAccessPoint     = s3URITypeAccessPoint
+	S3URITypeAccessPointARN  = s3URITypeAccessPointARN
+	S3URITypeDirectoryBucket = s3URITypeDirectoryBucket
+	S3URITypePathStyle       = s3URITypePathStyle
+	S3URITypeS3              = s3URITypeS3
+	S3URITypeVirtualHosted   = s3URITypeVirtualHosted
+)
+
+var (
+	ARNMatches           = arnMatches
+	BuildS3URI           = buildS3URI
+	CloudInitMultipart   = cloudInitMultipart
+	LoadScheduleLocation = loadScheduleLocation
+	ParseS3URI           = parseS3URI
+	PlanSubnets          = planSubnets
+	ScheduleNextRuns     = scheduleNextRuns
+	TagsEffective        = tagsEffective
+)
+
+type (
+	CloudInitPart    = cloudInitPart
+	S3URI            = s3URI
+	SubnetPlanSubnet = subnetPlanSubnet
+	SubnetPlanTier   = subnetPlanTier
+)
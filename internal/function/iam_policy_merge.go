@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+)
+
+var _ function.Function = iamPolicyMergeFunction{}
+
+func NewIAMPolicyMergeFunction() function.Function {
+	return &iamPolicyMergeFunction{}
+}
+
+type iamPolicyMergeFunction struct{}
+
+func (f iamPolicyMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_merge"
+}
+
+func (f iamPolicyMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_merge Function",
+		MarkdownDescription: "Merges IAM policy documents into a single normalized policy document. Statements in later " +
+			"documents replace statements with the same `Sid` in earlier documents. Statements without a `Sid` are appended.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				ElementType:         types.StringType,
+				Name:                "policies",
+				MarkdownDescription: "IAM policy document JSON, in merge order",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var args []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &args))
+	if resp.Error != nil {
+		return
+	}
+
+	doc, err := mergeIAMPolicies(args)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	result, err := encodeIAMPolicy(doc)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+func mergeIAMPolicies(policies []string) (*iampolicy.Doc, error) {
+	if len(policies) == 0 {
+		return nil, errors.New("at least one policy document is required")
+	}
+
+	merged := &iampolicy.Doc{}
+
+	for i, policy := range policies {
+		doc, err := decodeIAMPolicy(policy)
+		if err != nil {
+			return nil, fmt.Errorf("policy document %d: %w", i, err)
+		}
+
+		// Merge replaces only the first statement with a matching Sid.
+		sids := make(map[string]int)
+		for j, statement := range doc.Statements {
+			if statement.Sid == "" {
+				continue
+			}
+			if k, ok := sids[statement.Sid]; ok {
+				return nil, fmt.Errorf("policy document %d: duplicate Sid (%s) in statements %d and %d", i, statement.Sid, k, j)
+			}
+			sids[statement.Sid] = j
+		}
+
+		merged.Merge(doc)
+	}
+
+	return merged, nil
+}
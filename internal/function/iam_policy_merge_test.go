@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyMergeFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyMergeFunctionConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Version":"2012-10-17","Statement":[{"Action":"s3:*","Effect":"Deny","Resource":"*","Sid":"Objects"},{"Action":"s3:ListBucket","Effect":"Allow","Resource":"arn:aws:s3:::example"},{"Action":"kms:Decrypt","Effect":"Allow","Resource":"*"}]}`), //lintignore:AWSAT005
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_duplicateSid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyMergeFunctionConfig_duplicateSid(),
+				ExpectError: regexache.MustCompile(`duplicate[\s\n]*Sid[\s\n]*\(Objects\)`),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_empty(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyMergeFunctionConfig_empty(),
+				ExpectError: regexache.MustCompile(`at[\s\n]*least[\s\n]*one[\s\n]*policy[\s\n]*document`),
+			},
+		},
+	})
+}
+
+func testIAMPolicyMergeFunctionConfig_basic() string {
+	//lintignore:AWSAT005
+	return `
+output "test" {
+  value = provider::aws::iam_policy_merge([
+    jsonencode({
+      Version = "2012-10-17"
+      Statement = [
+        {
+          Sid      = "Objects"
+          Effect   = "Allow"
+          Action   = ["s3:GetObject", "s3:PutObject"]
+          Resource = "arn:aws:s3:::example/*"
+        },
+        {
+          Effect   = "Allow"
+          Action   = "s3:ListBucket"
+          Resource = "arn:aws:s3:::example"
+        },
+      ]
+    }),
+    jsonencode({
+      Version = "2012-10-17"
+      Statement = [
+        {
+          Sid      = "Objects"
+          Effect   = "Deny"
+          Action   = "s3:*"
+          Resource = "*"
+        },
+        {
+          Effect   = "Allow"
+          Action   = ["kms:Decrypt"]
+          Resource = "*"
+        },
+      ]
+    }),
+  ])
+}
+`
+}
+
+func testIAMPolicyMergeFunctionConfig_duplicateSid() string {
+	return `
+output "test" {
+  value = provider::aws::iam_policy_merge([
+    jsonencode({
+      Version = "2012-10-17"
+      Statement = [
+        {
+          Sid      = "Objects"
+          Effect   = "Allow"
+          Action   = "s3:GetObject"
+          Resource = "*"
+        },
+        {
+          Sid      = "Objects"
+          Effect   = "Allow"
+          Action   = "s3:PutObject"
+          Resource = "*"
+        },
+      ]
+    }),
+  ])
+}
+`
+}
+
+func testIAMPolicyMergeFunctionConfig_empty() string {
+	return `
+output "test" {
+  value = provider::aws::iam_policy_merge([])
+}
+`
+}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+)
+
+var _ function.Function = iamPolicyNormalizeFunction{}
+
+func NewIAMPolicyNormalizeFunction() function.Function {
+	return &iamPolicyNormalizeFunction{}
+}
+
+type iamPolicyNormalizeFunction struct{}
+
+func (f iamPolicyNormalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_normalize"
+}
+
+func (f iamPolicyNormalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_normalize Function",
+		MarkdownDescription: "Normalizes an IAM policy document. Single-element lists are flattened, and actions, " +
+			"resources, principals and condition values are sorted, so that equivalent policies produce identical JSON.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policy",
+				MarkdownDescription: "IAM policy document JSON",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	doc, err := decodeIAMPolicy(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	result, err := encodeIAMPolicy(doc)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// decodeIAMPolicy decodes an IAM policy document.
+// An error is returned if the policy has elements that the policy model cannot represent.
+func decodeIAMPolicy(policy string) (*iampolicy.Doc, error) {
+	doc := &iampolicy.Doc{}
+
+	if err := json.Unmarshal([]byte(policy), doc); err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	if !iampolicy.StringsEquivalent(policy, string(b)) {
+		return nil, errors.New("policy contains elements that cannot be normalized")
+	}
+
+	return doc, nil
+}
+
+// encodeIAMPolicy returns the normalized JSON for an IAM policy document, with the Version element first.
+func encodeIAMPolicy(doc *iampolicy.Doc) (string, error) {
+	for _, statement := range doc.Statements {
+		statement.Actions = normalizeIAMPolicyStrings(statement.Actions)
+		statement.NotActions = normalizeIAMPolicyStrings(statement.NotActions)
+		statement.Resources = normalizeIAMPolicyStrings(statement.Resources)
+		statement.NotResources = normalizeIAMPolicyStrings(statement.NotResources)
+		normalizeIAMPolicyPrincipals(statement.Principals)
+		normalizeIAMPolicyPrincipals(statement.NotPrincipals)
+		normalizeIAMPolicyConditions(statement.Conditions)
+	}
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+
+	return iampolicy.LegacyNormalize(string(b))
+}
+
+// normalizeIAMPolicyStrings returns a single string, or a list of strings in the order used by aws_iam_policy_document.
+func normalizeIAMPolicyStrings(v interface{}) interface{} {
+	var s []string
+
+	switch v := v.(type) {
+	case []interface{}:
+		for _, e := range v {
+			e, ok := e.(string)
+			if !ok {
+				return v
+			}
+			s = append(s, e)
+		}
+	case []string:
+		s = v
+	default:
+		return v
+	}
+
+	if len(s) == 1 {
+		return s[0]
+	}
+
+	sort.Sort(sort.Reverse(sort.StringSlice(s)))
+
+	return s
+}
+
+func normalizeIAMPolicyPrincipals(ps iampolicy.StatementPrincipalSet) {
+	for i, p := range ps {
+		ps[i].Identifiers = normalizeIAMPolicyStrings(p.Identifiers)
+	}
+
+	slices.SortFunc(ps, func(a, b iampolicy.StatementPrincipal) int {
+		return cmp.Compare(a.Type, b.Type)
+	})
+}
+
+func normalizeIAMPolicyConditions(cs iampolicy.StatementConditionSet) {
+	for i, c := range cs {
+		cs[i].Values = normalizeIAMPolicyStrings(c.Values)
+	}
+
+	slices.SortFunc(cs, func(a, b iampolicy.StatementCondition) int {
+		return cmp.Or(cmp.Compare(a.Test, b.Test), cmp.Compare(a.Variable, b.Variable))
+	})
+}
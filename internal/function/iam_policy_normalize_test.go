@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyNormalizeFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyNormalizeFunctionConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Version":"2012-10-17","Statement":[{"Action":["s3:PutObject","s3:GetObject"],"Condition":{"Bool":{"aws:SecureTransport":"true"},"StringEquals":{"aws:PrincipalTag/team":["platform","data"]}},"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::444455556666:root","arn:aws:iam::111122223333:root"],"Service":"ec2.amazonaws.com"},"Resource":"*","Sid":"Example"}]}`), //lintignore:AWSAT005
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyNormalizeFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyNormalizeFunctionConfig_invalid(),
+				ExpectError: regexache.MustCompile(`invalid[\s\n]*character`),
+			},
+		},
+	})
+}
+
+func testIAMPolicyNormalizeFunctionConfig_basic() string {
+	//lintignore:AWSAT005
+	return `
+output "test" {
+  value = provider::aws::iam_policy_normalize(jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid      = "Example"
+      Effect   = "Allow"
+      Action   = ["s3:GetObject", "s3:PutObject"]
+      Resource = ["*"]
+      Principal = {
+        AWS     = ["arn:aws:iam::111122223333:root", "arn:aws:iam::444455556666:root"]
+        Service = ["ec2.amazonaws.com"]
+      }
+      Condition = {
+        StringEquals = {
+          "aws:PrincipalTag/team" = ["data", "platform"]
+        }
+        Bool = {
+          "aws:SecureTransport" = true
+        }
+      }
+    }]
+  }))
+}
+`
+}
+
+func testIAMPolicyNormalizeFunctionConfig_invalid() string {
+	return `
+output "test" {
+  value = provider::aws::iam_policy_normalize("invalid")
+}
+`
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var _ function.Function = s3URIBuildFunction{}
+
+func NewS3URIBuildFunction() function.Function {
+	return &s3URIBuildFunction{}
+}
+
+type s3URIBuildFunction struct{}
+
+func (f s3URIBuildFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "s3_uri_build"
+}
+
+func (f s3URIBuildFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "s3_uri_build Function",
+		MarkdownDescription: "Builds an S3 URI of the specified type from a bucket, key and Region",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "type",
+				MarkdownDescription: "Type of URI to build: " + strings.Join(s3URITypes(), ", "),
+			},
+			function.StringParameter{
+				Name:                "bucket",
+				MarkdownDescription: "Bucket name, or access point ARN for the `access-point` and `access-point-arn` types",
+			},
+			function.StringParameter{
+				AllowNullValue:      true,
+				Name:                "key",
+				MarkdownDescription: "Object key, or `null`",
+			},
+			function.StringParameter{
+				AllowNullValue:      true,
+				Name:                "region",
+				MarkdownDescription: "Region, or `null` for the global endpoint or to use the access point ARN's Region",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f s3URIBuildFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var typ, bucket string
+	var key, region types.String
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &typ, &bucket, &key, &region))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := buildS3URI(typ, bucket, key.ValueString(), region.ValueString())
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+func buildS3URI(typ, bucket, key, region string) (string, error) {
+	if bucket == "" {
+		return "", errors.New("bucket is required")
+	}
+
+	switch typ {
+	case s3URITypeS3:
+		return "s3://" + joinS3Key(bucket, key), nil
+
+	case s3URITypeVirtualHosted:
+		return s3URL(bucket+"."+s3Endpoint(region), key), nil
+
+	case s3URITypePathStyle:
+		return s3URL(s3Endpoint(region), joinS3Key(bucket, key)), nil
+
+	case s3URITypeAccessPoint, s3URITypeAccessPointARN:
+		uri, err := parseS3AccessPointARN(bucket)
+		if err != nil {
+			return "", fmt.Errorf("bucket: %w", err)
+		}
+		if uri.Key != "" {
+			return "", fmt.Errorf("bucket (%s) must be an access point ARN without an object key", bucket)
+		}
+		if region != "" && region != uri.Region {
+			return "", fmt.Errorf("region (%s) does not match access point ARN Region (%s)", region, uri.Region)
+		}
+
+		if typ == s3URITypeAccessPointARN {
+			if key == "" {
+				return bucket, nil
+			}
+			return bucket + "/object/" + key, nil
+		}
+
+		host := fmt.Sprintf("%s-%s.s3-accesspoint.%s.%s", uri.AccessPoint, uri.AccountID, uri.Region, names.DNSSuffixForPartition(uri.Partition))
+		return s3URL(host, key), nil
+
+	case s3URITypeDirectoryBucket:
+		m := s3DirectoryBucketNameRegex.FindStringSubmatch(bucket)
+		if m == nil {
+			return "", fmt.Errorf("bucket (%s) must be in the format [bucket_name]--[azid]--x-s3", bucket)
+		}
+		if region == "" {
+			return "", errors.New("region is required for directory buckets")
+		}
+
+		host := fmt.Sprintf("%s.s3express-%s.%s.%s", bucket, m[2], region, names.DNSSuffixForPartition(names.PartitionForRegion(region)))
+		return s3URL(host, key), nil
+
+	default:
+		return "", fmt.Errorf("type (%s) must be one of: %s", typ, strings.Join(s3URITypes(), ", "))
+	}
+}
+
+// s3Endpoint returns the S3 endpoint host name for the specified Region, or the global endpoint if the Region is empty.
+func s3Endpoint(region string) string {
+	if region == "" {
+		return "s3." + names.DNSSuffixForPartition(names.StandardPartitionID)
+	}
+
+	return fmt.Sprintf("s3.%s.%s", region, names.DNSSuffixForPartition(names.PartitionForRegion(region)))
+}
+
+func joinS3Key(bucket, key string) string {
+	if key == "" {
+		return bucket
+	}
+
+	return bucket + "/" + key
+}
+
+// s3URL returns an HTTPS URL with the specified host and path, escaping the path as needed.
+func s3URL(host, path string) string {
+	u := url.URL{
+		Scheme: "https",
+		Host:   host,
+	}
+	if path != "" {
+		u.Path = "/" + path
+	}
+
+	return u.String()
+}
@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+)
+
+func TestS3URIBuildFunction_pathStyle(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIBuildFunctionConfig_pathStyle(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://s3.eu-west-1.amazonaws.com/example/object.txt"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIBuildFunction_accessPoint(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIBuildFunctionConfig_accessPoint(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://finance-docs-123456789012.s3-accesspoint.us-west-2.amazonaws.com/reports/q1.csv"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIBuildFunction_invalidType(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testS3URIBuildFunctionConfig_invalidType(),
+				ExpectError: regexache.MustCompile(`type[\s\n]*\(website\)[\s\n]*must[\s\n]*be[\s\n]*one[\s\n]*of`),
+			},
+		},
+	})
+}
+
+func testS3URIBuildFunctionConfig_pathStyle() string {
+	return `
+output "test" {
+  value = provider::aws::s3_uri_build("path-style", "example", "object.txt", "eu-west-1")
+}
+`
+}
+
+func testS3URIBuildFunctionConfig_accessPoint() string {
+	//lintignore:AWSAT003,AWSAT005
+	return `
+output "test" {
+  value = provider::aws::s3_uri_build("access-point", "arn:aws:s3:us-west-2:123456789012:accesspoint/finance-docs", "reports/q1.csv", null)
+}
+`
+}
+
+func testS3URIBuildFunctionConfig_invalidType() string {
+	return `
+output "test" {
+  value = provider::aws::s3_uri_build("website", "example", null, null)
+}
+`
+}
+
+func TestBuildS3URI(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		typ           string
+		bucket        string
+		key           string
+		region        string
+		expected      string
+		expectedError string
+	}{
+		{
+			name:     "s3",
+			typ:      tffunction.S3URITypeS3,
+			bucket:   "example",
+			key:      "path/to/object.txt",
+			expected: "s3://example/path/to/object.txt",
+		},
+		{
+			name:     "s3 bucket only",
+			typ:      tffunction.S3URITypeS3,
+			bucket:   "example",
+			expected: "s3://example",
+		},
+		{
+			name:     "virtual-hosted",
+			typ:      tffunction.S3URITypeVirtualHosted,
+			bucket:   "example",
+			key:      "path/to/object name.txt",
+			region:   "us-west-2",
+			expected: "https://example.s3.us-west-2.amazonaws.com/path/to/object%20name.txt",
+		},
+		{
+			name:     "virtual-hosted global endpoint",
+			typ:      tffunction.S3URITypeVirtualHosted,
+			bucket:   "example",
+			key:      "object.txt",
+			expected: "https://example.s3.amazonaws.com/object.txt",
+		},
+		{
+			name:     "virtual-hosted China",
+			typ:      tffunction.S3URITypeVirtualHosted,
+			bucket:   "example",
+			region:   "cn-northwest-1",
+			expected: "https://example.s3.cn-northwest-1.amazonaws.com.cn",
+		},
+		{
+			name:     "path-style",
+			typ:      tffunction.S3URITypePathStyle,
+			bucket:   "example",
+			key:      "object.txt",
+			region:   "eu-west-1",
+			expected: "https://s3.eu-west-1.amazonaws.com/example/object.txt",
+		},
+		{
+			name:     "access point",
+			typ:      tffunction.S3URITypeAccessPoint,
+			bucket:   "arn:aws:s3:us-west-2:123456789012:accesspoint/finance-docs", //lintignore:AWSAT003,AWSAT005
+			key:      "reports/q1.csv",
+			expected: "https://finance-docs-123456789012.s3-accesspoint.us-west-2.amazonaws.com/reports/q1.csv",
+		},
+		{
+			name:          "access point Region mismatch",
+			typ:           tffunction.S3URITypeAccessPoint,
+			bucket:        "arn:aws:s3:us-west-2:123456789012:accesspoint/finance-docs", //lintignore:AWSAT003,AWSAT005
+			region:        "us-east-1",
+			expectedError: "region (us-east-1) does not match access point ARN Region (us-west-2)",
+		},
+		{
+			name:          "access point bucket name",
+			typ:           tffunction.S3URITypeAccessPoint,
+			bucket:        "example",
+			expectedError: "bucket: arn: invalid prefix",
+		},
+		{
+			name:     "access point ARN",
+			typ:      tffunction.S3URITypeAccessPointARN,
+			bucket:   "arn:aws:s3:us-west-2:123456789012:accesspoint/finance-docs", //lintignore:AWSAT003,AWSAT005
+			key:      "reports/q1.csv",
+			expected: "arn:aws:s3:us-west-2:123456789012:accesspoint/finance-docs/object/reports/q1.csv", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			name:     "directory bucket",
+			typ:      tffunction.S3URITypeDirectoryBucket,
+			bucket:   "example--usw2-az1--x-s3",
+			key:      "object.txt",
+			region:   "us-west-2",
+			expected: "https://example--usw2-az1--x-s3.s3express-usw2-az1.us-west-2.amazonaws.com/object.txt",
+		},
+		{
+			name:          "directory bucket invalid name",
+			typ:           tffunction.S3URITypeDirectoryBucket,
+			bucket:        "example",
+			region:        "us-west-2",
+			expectedError: "bucket (example) must be in the format [bucket_name]--[azid]--x-s3",
+		},
+		{
+			name:          "directory bucket no Region",
+			typ:           tffunction.S3URITypeDirectoryBucket,
+			bucket:        "example--usw2-az1--x-s3",
+			expectedError: "region is required for directory buckets",
+		},
+		{
+			name:          "no bucket",
+			typ:           tffunction.S3URITypeS3,
+			expectedError: "bucket is required",
+		},
+		{
+			name:          "invalid type",
+			typ:           "website",
+			bucket:        "example",
+			expectedError: "type (website) must be one of: s3, virtual-hosted, path-style, access-point, access-point-arn, directory-bucket",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tffunction.BuildS3URI(testCase.typ, testCase.bucket, testCase.key, testCase.region)
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error %q, got none", testCase.expectedError)
+				}
+				if got, want := err.Error(), testCase.expectedError; got != want {
+					t.Errorf("unexpected error: got %q, want %q", got, want)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("BuildS3URI(%q, %q, %q, %q) = %q, want %q", testCase.typ, testCase.bucket, testCase.key, testCase.region, got, testCase.expected)
+			}
+
+			// Parsing the URI returns the arguments used to build it.
+			uri, err := tffunction.ParseS3URI(got)
+			if err != nil {
+				t.Fatalf("unexpected error parsing %q: %s", got, err)
+			}
+			if uri.Type != testCase.typ || uri.Bucket != testCase.bucket || uri.Key != testCase.key {
+				t.Errorf("ParseS3URI(%q) = (%q, %q, %q), want (%q, %q, %q)", got, uri.Type, uri.Bucket, uri.Key, testCase.typ, testCase.bucket, testCase.key)
+			}
+		})
+	}
+}
@@ -0,0 +1,311 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// S3 URI types.
+const (
+	s3URITypeS3              = "s3"               // s3://bucket/key
+	s3URITypeVirtualHosted   = "virtual-hosted"   // https://bucket.s3.region.amazonaws.com/key
+	s3URITypePathStyle       = "path-style"       // https://s3.region.amazonaws.com/bucket/key
+	s3URITypeAccessPoint     = "access-point"     // https://name-account.s3-accesspoint.region.amazonaws.com/key
+	s3URITypeAccessPointARN  = "access-point-arn" // arn:aws:s3:region:account:accesspoint/name/object/key
+	s3URITypeDirectoryBucket = "directory-bucket" // https://bucket--azid--x-s3.s3express-azid.region.amazonaws.com/key
+)
+
+func s3URITypes() []string {
+	return []string{
+		s3URITypeS3,
+		s3URITypeVirtualHosted,
+		s3URITypePathStyle,
+		s3URITypeAccessPoint,
+		s3URITypeAccessPointARN,
+		s3URITypeDirectoryBucket,
+	}
+}
+
+var (
+	// e.g. example--usw2-az2--x-s3
+	s3DirectoryBucketNameRegex = regexache.MustCompile(`^([0-9a-z.-]+)--([a-z]+\d+-az\d+)--x-s3$`)
+
+	// Host names, without the partition's DNS suffix.
+	s3DirectoryBucketHostRegex = regexache.MustCompile(`^([0-9a-z.-]+--[a-z]+\d+-az\d+--x-s3)\.s3express-([a-z]+\d+-az\d+)\.([0-9a-z-]+)$`)
+	s3AccessPointHostRegex     = regexache.MustCompile(`^([0-9a-z-]+)-(\d{12})\.s3-accesspoint(?:-fips)?(?:\.dualstack)?\.([0-9a-z-]+)$`)
+	s3HostRegex                = regexache.MustCompile(`^(?:(.+)\.)?s3(?:-fips)?(?:\.dualstack)?(?:[.-]([0-9a-z-]+))?$`)
+)
+
+var s3URIParseResultAttrTypes = map[string]attr.Type{
+	"type":                 types.StringType,
+	"partition":            types.StringType,
+	"region":               types.StringType,
+	"bucket":               types.StringType,
+	"key":                  types.StringType,
+	"access_point":         types.StringType,
+	"account_id":           types.StringType,
+	"availability_zone_id": types.StringType,
+}
+
+var _ function.Function = s3URIParseFunction{}
+
+func NewS3URIParseFunction() function.Function {
+	return &s3URIParseFunction{}
+}
+
+type s3URIParseFunction struct{}
+
+func (f s3URIParseFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "s3_uri_parse"
+}
+
+func (f s3URIParseFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "s3_uri_parse Function",
+		MarkdownDescription: "Parses an S3 URI into its type, bucket, key and Region. Supports `s3://` URIs, virtual-hosted-style " +
+			"and path-style URLs, access point URLs and ARNs, and S3 Express One Zone directory bucket URLs",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "uri",
+				MarkdownDescription: "S3 URI to parse",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: s3URIParseResultAttrTypes,
+		},
+	}
+}
+
+func (f s3URIParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	uri, err := parseS3URI(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	value := map[string]attr.Value{
+		"type":                 types.StringValue(uri.Type),
+		"partition":            types.StringValue(uri.Partition),
+		"region":               types.StringValue(uri.Region),
+		"bucket":               types.StringValue(uri.Bucket),
+		"key":                  types.StringValue(uri.Key),
+		"access_point":         types.StringValue(uri.AccessPoint),
+		"account_id":           types.StringValue(uri.AccountID),
+		"availability_zone_id": types.StringValue(uri.AvailabilityZoneID),
+	}
+
+	result, d := types.ObjectValue(s3URIParseResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+type s3URI struct {
+	Type      string
+	Partition string
+	Region    string
+	// For access points, the access point ARN, which S3 accepts in place of a bucket name.
+	Bucket             string
+	Key                string
+	AccessPoint        string
+	AccountID          string
+	AvailabilityZoneID string
+}
+
+func parseS3URI(s string) (*s3URI, error) {
+	if v, ok := strings.CutPrefix(s, "s3://"); ok {
+		bucket, key, _ := strings.Cut(v, "/")
+		if bucket == "" {
+			return nil, fmt.Errorf("S3 URI (%s) has no bucket", s)
+		}
+
+		uri := &s3URI{
+			Type:   s3URITypeS3,
+			Bucket: bucket,
+			Key:    key,
+		}
+		if m := s3DirectoryBucketNameRegex.FindStringSubmatch(bucket); m != nil {
+			uri.AvailabilityZoneID = m[2]
+		}
+
+		return uri, nil
+	}
+
+	if arn.IsARN(s) {
+		return parseS3AccessPointARN(s)
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return nil, fmt.Errorf("S3 URI (%s) must be an s3://, https:// or http:// URI, or an access point ARN", s)
+	}
+
+	host, partition, err := cutS3DNSSuffix(strings.ToLower(u.Hostname()))
+	if err != nil {
+		return nil, fmt.Errorf("S3 URI (%s): %w", s, err)
+	}
+
+	path := strings.TrimPrefix(u.Path, "/")
+
+	if m := s3DirectoryBucketHostRegex.FindStringSubmatch(host); m != nil {
+		return &s3URI{
+			Type:               s3URITypeDirectoryBucket,
+			Partition:          s3Partition(partition, m[3]),
+			Region:             m[3],
+			Bucket:             m[1],
+			Key:                path,
+			AvailabilityZoneID: m[2],
+		}, nil
+	}
+
+	if m := s3AccessPointHostRegex.FindStringSubmatch(host); m != nil {
+		partition := s3Partition(partition, m[3])
+
+		return &s3URI{
+			Type:        s3URITypeAccessPoint,
+			Partition:   partition,
+			Region:      m[3],
+			Bucket:      s3AccessPointARN(partition, m[3], m[2], m[1]),
+			Key:         path,
+			AccessPoint: m[1],
+			AccountID:   m[2],
+		}, nil
+	}
+
+	m := s3HostRegex.FindStringSubmatch(host)
+	if m == nil {
+		return nil, fmt.Errorf("S3 URI (%s): host (%s) is not an S3 endpoint", s, u.Hostname())
+	}
+
+	region := m[2]
+	// The legacy s3-external-1 endpoint is in US East (N. Virginia).
+	if region == "external-1" {
+		region = names.USEast1RegionID
+	}
+
+	uri := &s3URI{
+		Partition: s3Partition(partition, region),
+		Region:    region,
+	}
+
+	if m[1] != "" {
+		uri.Type = s3URITypeVirtualHosted
+		uri.Bucket = m[1]
+		uri.Key = path
+	} else {
+		uri.Type = s3URITypePathStyle
+		uri.Bucket, uri.Key, _ = strings.Cut(path, "/")
+		if uri.Bucket == "" {
+			return nil, fmt.Errorf("S3 URI (%s) has no bucket", s)
+		}
+	}
+
+	return uri, nil
+}
+
+// parseS3AccessPointARN parses an access point ARN, optionally followed by "/object/" and an object key.
+func parseS3AccessPointARN(s string) (*s3URI, error) {
+	a, err := arn.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if a.Service != "s3" || a.Region == "" || a.AccountID == "" {
+		return nil, fmt.Errorf("S3 URI (%s): only S3 access point ARNs are supported", s)
+	}
+
+	v, ok := strings.CutPrefix(a.Resource, "accesspoint/")
+	if !ok {
+		return nil, fmt.Errorf("S3 URI (%s): only S3 access point ARNs are supported", s)
+	}
+
+	name, key, hasKey := strings.Cut(v, "/")
+	if hasKey {
+		if key, ok = strings.CutPrefix(key, "object/"); !ok {
+			return nil, fmt.Errorf("S3 URI (%s): access point ARN resource must be accesspoint/name or accesspoint/name/object/key", s)
+		}
+	}
+	if name == "" {
+		return nil, fmt.Errorf("S3 URI (%s) has no access point name", s)
+	}
+
+	return &s3URI{
+		Type:        s3URITypeAccessPointARN,
+		Partition:   a.Partition,
+		Region:      a.Region,
+		Bucket:      s3AccessPointARN(a.Partition, a.Region, a.AccountID, name),
+		Key:         key,
+		AccessPoint: name,
+		AccountID:   a.AccountID,
+	}, nil
+}
+
+// cutS3DNSSuffix returns a host name without its partition's DNS suffix, and the partition.
+// The partition is empty if the DNS suffix is shared by several partitions.
+func cutS3DNSSuffix(host string) (string, string, error) {
+	for _, partition := range []string{
+		names.StandardPartitionID,
+		names.ChinaPartitionID,
+		names.ISOPartitionID,
+		names.ISOBPartitionID,
+		names.ISOEPartitionID,
+		names.ISOFPartitionID,
+	} {
+		if v, ok := strings.CutSuffix(host, "."+names.DNSSuffixForPartition(partition)); ok {
+			// The AWS GovCloud (US) partition shares the standard partition's DNS suffix.
+			if partition == names.StandardPartitionID {
+				partition = ""
+			}
+			return v, partition, nil
+		}
+	}
+
+	return "", "", errors.New("host is not an AWS endpoint")
+}
+
+// s3Partition returns the specified partition, or the partition of the specified Region if the partition is empty.
+func s3Partition(partition, region string) string {
+	if partition != "" {
+		return partition
+	}
+	if region == "" {
+		return names.StandardPartitionID
+	}
+	return names.PartitionForRegion(region)
+}
+
+func s3AccessPointARN(partition, region, accountID, name string) string {
+	return arn.ARN{
+		Partition: partition,
+		Service:   "s3",
+		Region:    region,
+		AccountID: accountID,
+		Resource:  "accesspoint/" + name,
+	}.String()
+}
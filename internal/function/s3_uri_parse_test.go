@@ -0,0 +1,311 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+)
+
+func TestS3URIParseFunction_virtualHosted(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIParseFunctionConfig("https://example.s3.us-west-2.amazonaws.com/path/to/object.txt"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("type", "virtual-hosted"),
+					resource.TestCheckOutput("bucket", "example"),
+					resource.TestCheckOutput("key", "path/to/object.txt"),
+					resource.TestCheckOutput("region", "us-west-2"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIParseFunction_directoryBucket(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testS3URIParseFunctionConfig("https://example--usw2-az1--x-s3.s3express-usw2-az1.us-west-2.amazonaws.com/object.txt"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("type", "directory-bucket"),
+					resource.TestCheckOutput("bucket", "example--usw2-az1--x-s3"),
+					resource.TestCheckOutput("key", "object.txt"),
+					resource.TestCheckOutput("region", "us-west-2"),
+				),
+			},
+		},
+	})
+}
+
+func TestS3URIParseFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testS3URIParseFunctionConfig("https://example.com/object.txt"),
+				ExpectError: regexache.MustCompile(`host[\s\n]*is[\s\n]*not[\s\n]*an[\s\n]*AWS[\s\n]*endpoint`),
+			},
+		},
+	})
+}
+
+func testS3URIParseFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+locals {
+  uri = provider::aws::s3_uri_parse(%[1]q)
+}
+
+output "type" {
+  value = local.uri.type
+}
+
+output "bucket" {
+  value = local.uri.bucket
+}
+
+output "key" {
+  value = local.uri.key
+}
+
+output "region" {
+  value = local.uri.region
+}
+`, arg)
+}
+
+func TestParseS3URI(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		uri           string
+		expected      *tffunction.S3URI
+		expectedError string
+	}{
+		{
+			name: "s3",
+			uri:  "s3://example/path/to/object.txt",
+			expected: &tffunction.S3URI{
+				Type:   tffunction.S3URITypeS3,
+				Bucket: "example",
+				Key:    "path/to/object.txt",
+			},
+		},
+		{
+			name: "s3 bucket only",
+			uri:  "s3://example",
+			expected: &tffunction.S3URI{
+				Type:   tffunction.S3URITypeS3,
+				Bucket: "example",
+			},
+		},
+		{
+			name: "s3 directory bucket",
+			uri:  "s3://example--usw2-az1--x-s3/object.txt",
+			expected: &tffunction.S3URI{
+				Type:               tffunction.S3URITypeS3,
+				Bucket:             "example--usw2-az1--x-s3",
+				Key:                "object.txt",
+				AvailabilityZoneID: "usw2-az1",
+			},
+		},
+		{
+			name:          "s3 no bucket",
+			uri:           "s3:///object.txt",
+			expectedError: "S3 URI (s3:///object.txt) has no bucket",
+		},
+		{
+			name: "virtual-hosted",
+			uri:  "https://example.s3.us-west-2.amazonaws.com/path/to/object%20name.txt",
+			expected: &tffunction.S3URI{
+				Type:      tffunction.S3URITypeVirtualHosted,
+				Partition: "aws",
+				Region:    "us-west-2",
+				Bucket:    "example",
+				Key:       "path/to/object name.txt",
+			},
+		},
+		{
+			name: "virtual-hosted global endpoint",
+			uri:  "https://my.example.s3.amazonaws.com/object.txt",
+			expected: &tffunction.S3URI{
+				Type:      tffunction.S3URITypeVirtualHosted,
+				Partition: "aws",
+				Bucket:    "my.example",
+				Key:       "object.txt",
+			},
+		},
+		{
+			name: "virtual-hosted legacy dash Region",
+			uri:  "https://example.s3-eu-west-1.amazonaws.com/object.txt",
+			expected: &tffunction.S3URI{
+				Type:      tffunction.S3URITypeVirtualHosted,
+				Partition: "aws",
+				Region:    "eu-west-1",
+				Bucket:    "example",
+				Key:       "object.txt",
+			},
+		},
+		{
+			name: "virtual-hosted dual-stack",
+			uri:  "https://example.s3.dualstack.us-gov-west-1.amazonaws.com/object.txt",
+			expected: &tffunction.S3URI{
+				Type:      tffunction.S3URITypeVirtualHosted,
+				Partition: "aws-us-gov",
+				Region:    "us-gov-west-1",
+				Bucket:    "example",
+				Key:       "object.txt",
+			},
+		},
+		{
+			name: "virtual-hosted China",
+			uri:  "https://example.s3.cn-north-1.amazonaws.com.cn/object.txt",
+			expected: &tffunction.S3URI{
+				Type:      tffunction.S3URITypeVirtualHosted,
+				Partition: "aws-cn",
+				Region:    "cn-north-1",
+				Bucket:    "example",
+				Key:       "object.txt",
+			},
+		},
+		{
+			name: "path-style",
+			uri:  "https://s3.us-west-2.amazonaws.com/example/path/to/object.txt",
+			expected: &tffunction.S3URI{
+				Type:      tffunction.S3URITypePathStyle,
+				Partition: "aws",
+				Region:    "us-west-2",
+				Bucket:    "example",
+				Key:       "path/to/object.txt",
+			},
+		},
+		{
+			name: "path-style legacy external endpoint",
+			uri:  "http://s3-external-1.amazonaws.com/example",
+			expected: &tffunction.S3URI{
+				Type:      tffunction.S3URITypePathStyle,
+				Partition: "aws",
+				Region:    "us-east-1",
+				Bucket:    "example",
+			},
+		},
+		{
+			name:          "path-style no bucket",
+			uri:           "https://s3.us-west-2.amazonaws.com/",
+			expectedError: "S3 URI (https://s3.us-west-2.amazonaws.com/) has no bucket",
+		},
+		{
+			name: "access point",
+			uri:  "https://finance-docs-123456789012.s3-accesspoint.us-west-2.amazonaws.com/reports/q1.csv",
+			expected: &tffunction.S3URI{
+				Type:        tffunction.S3URITypeAccessPoint,
+				Partition:   "aws",
+				Region:      "us-west-2",
+				Bucket:      "arn:aws:s3:us-west-2:123456789012:accesspoint/finance-docs", //lintignore:AWSAT003,AWSAT005
+				Key:         "reports/q1.csv",
+				AccessPoint: "finance-docs",
+				AccountID:   "123456789012",
+			},
+		},
+		{
+			name: "access point ARN",
+			uri:  "arn:aws:s3:us-west-2:123456789012:accesspoint/finance-docs/object/reports/q1.csv", //lintignore:AWSAT003,AWSAT005
+			expected: &tffunction.S3URI{
+				Type:        tffunction.S3URITypeAccessPointARN,
+				Partition:   "aws",
+				Region:      "us-west-2",
+				Bucket:      "arn:aws:s3:us-west-2:123456789012:accesspoint/finance-docs", //lintignore:AWSAT003,AWSAT005
+				Key:         "reports/q1.csv",
+				AccessPoint: "finance-docs",
+				AccountID:   "123456789012",
+			},
+		},
+		{
+			name:          "bucket ARN",
+			uri:           "arn:aws:s3:::example/object.txt", //lintignore:AWSAT005
+			expectedError: "S3 URI (arn:aws:s3:::example/object.txt): only S3 access point ARNs are supported",
+		},
+		{
+			name: "directory bucket",
+			uri:  "https://example--usw2-az1--x-s3.s3express-usw2-az1.us-west-2.amazonaws.com/object.txt",
+			expected: &tffunction.S3URI{
+				Type:               tffunction.S3URITypeDirectoryBucket,
+				Partition:          "aws",
+				Region:             "us-west-2",
+				Bucket:             "example--usw2-az1--x-s3",
+				Key:                "object.txt",
+				AvailabilityZoneID: "usw2-az1",
+			},
+		},
+		{
+			name:          "not S3",
+			uri:           "https://example.com/object.txt",
+			expectedError: "S3 URI (https://example.com/object.txt): host is not an AWS endpoint",
+		},
+		{
+			name:          "not S3 endpoint",
+			uri:           "https://ec2.us-west-2.amazonaws.com/",
+			expectedError: "S3 URI (https://ec2.us-west-2.amazonaws.com/): host (ec2.us-west-2.amazonaws.com) is not an S3 endpoint",
+		},
+		{
+			name:          "unsupported scheme",
+			uri:           "ftp://example.s3.amazonaws.com/object.txt",
+			expectedError: "S3 URI (ftp://example.s3.amazonaws.com/object.txt) must be an s3://, https:// or http:// URI, or an access point ARN",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tffunction.ParseS3URI(testCase.uri)
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error %q, got none", testCase.expectedError)
+				}
+				if got, want := err.Error(), testCase.expectedError; got != want {
+					t.Errorf("unexpected error: got %q, want %q", got, want)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,496 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	_ "time/tzdata" // Embed the IANA Time Zone database so that results don't depend on the host.
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	scheduleNextRunsMaxCount = 1000
+
+	cronMinYear = 1970
+	cronMaxYear = 2199
+)
+
+var (
+	cronMonthNames = map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}
+	cronDayOfWeekNames = map[string]int{
+		"SUN": 1, "MON": 2, "TUE": 3, "WED": 4, "THU": 5, "FRI": 6, "SAT": 7,
+	}
+)
+
+var _ function.Function = scheduleNextRunsFunction{}
+
+func NewScheduleNextRunsFunction() function.Function {
+	return &scheduleNextRunsFunction{}
+}
+
+type scheduleNextRunsFunction struct{}
+
+func (f scheduleNextRunsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "schedule_next_runs"
+}
+
+func (f scheduleNextRunsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "schedule_next_runs Function",
+		MarkdownDescription: "Returns the times, at or after a start time, at which an AWS `rate()`, `cron()` or `at()` " +
+			"schedule expression runs. Cron expressions use the 6-field AWS dialect, including the `L`, `W` and `#` wildcards",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "expression",
+				MarkdownDescription: "Schedule expression, e.g. `cron(0 10 ? * MON-FRI *)`",
+			},
+			function.StringParameter{
+				AllowNullValue:      true,
+				Name:                "timezone",
+				MarkdownDescription: "IANA time zone in which the expression is evaluated, e.g. `Europe/Paris`, or `null` for UTC",
+			},
+			function.Int64Parameter{
+				Name:                "count",
+				MarkdownDescription: fmt.Sprintf("Maximum number of times to return, between 1 and %d", scheduleNextRunsMaxCount),
+			},
+			function.StringParameter{
+				Name:                "start",
+				MarkdownDescription: "RFC 3339 timestamp from which to compute run times",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f scheduleNextRunsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expression, start string
+	var timezone types.String
+	var count int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &expression, &timezone, &count, &start))
+	if resp.Error != nil {
+		return
+	}
+
+	loc, err := loadScheduleLocation(timezone.ValueString())
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	if count < 1 || count > scheduleNextRunsMaxCount {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("count must be between 1 and %d, got %d", scheduleNextRunsMaxCount, count)))
+		return
+	}
+
+	t, err := time.Parse(time.RFC3339, start)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("start: %s", err)))
+		return
+	}
+
+	runs, err := scheduleNextRuns(expression, loc, int(count), t)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	result := make([]string, 0, len(runs))
+	for _, run := range runs {
+		result = append(result, run.In(loc).Format(time.RFC3339))
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// loadScheduleLocation returns the location with the specified IANA time zone name, or UTC if the name is empty.
+func loadScheduleLocation(name string) (*time.Location, error) {
+	// The host's local time zone would make results differ between machines.
+	if name == "Local" {
+		return nil, fmt.Errorf("timezone (%s) must be an IANA time zone name", name)
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("timezone (%s): %w", name, err)
+	}
+
+	return loc, nil
+}
+
+// scheduleNextRuns returns up to count times, at or after start, at which a schedule expression runs.
+// The first run of a rate expression is at start.
+func scheduleNextRuns(expression string, loc *time.Location, count int, start time.Time) ([]time.Time, error) {
+	if v, ok := cutSchedule(expression, "rate"); ok {
+		interval, err := parseRateExpression(v)
+		if err != nil {
+			return nil, fmt.Errorf("rate expression (%s): %w", expression, err)
+		}
+
+		runs := make([]time.Time, 0, count)
+		for t := start; len(runs) < count; t = t.Add(interval) {
+			runs = append(runs, t)
+		}
+
+		return runs, nil
+	}
+
+	if v, ok := cutSchedule(expression, "cron"); ok {
+		schedule, err := parseCronExpression(v)
+		if err != nil {
+			return nil, fmt.Errorf("cron expression (%s): %w", expression, err)
+		}
+
+		return schedule.nextRuns(loc, count, start), nil
+	}
+
+	if v, ok := cutSchedule(expression, "at"); ok {
+		t, err := time.ParseInLocation("2006-01-02T15:04:05", v, loc)
+		if err != nil {
+			return nil, fmt.Errorf("at expression (%s): must be in the format yyyy-mm-ddThh:mm:ss", expression)
+		}
+
+		if t.Before(start) {
+			return []time.Time{}, nil
+		}
+
+		return []time.Time{t}, nil
+	}
+
+	return nil, fmt.Errorf("schedule expression (%s) must be a rate(), cron() or at() expression", expression)
+}
+
+// cutSchedule returns the arguments of a schedule expression of the specified type, e.g. "rate(5 minutes)".
+func cutSchedule(expression, name string) (string, bool) {
+	v, ok := strings.CutPrefix(expression, name+"(")
+	if !ok {
+		return "", false
+	}
+
+	v, ok = strings.CutSuffix(v, ")")
+	if !ok {
+		return "", false
+	}
+
+	return strings.TrimSpace(v), true
+}
+
+func parseRateExpression(s string) (time.Duration, error) {
+	match := regexache.MustCompile(`^(\d+)\s+(minute|hour|day)(s?)$`).FindStringSubmatch(s)
+	if match == nil {
+		return 0, errors.New("must be in the format rate(value unit), where unit is minute(s), hour(s) or day(s)")
+	}
+
+	value, err := strconv.ParseInt(match[1], 10, 64)
+	if err != nil || value < 1 {
+		return 0, errors.New("value must be a positive integer")
+	}
+
+	// AWS requires the singular unit for a value of 1, and the plural unit otherwise.
+	if plural := match[3] != ""; plural != (value > 1) {
+		if plural {
+			return 0, fmt.Errorf("unit must be %s for a value of 1", match[2])
+		}
+		return 0, fmt.Errorf("unit must be %ss for a value greater than 1", match[2])
+	}
+
+	var unit time.Duration
+	switch match[2] {
+	case "minute":
+		unit = time.Minute
+	case "hour":
+		unit = time.Hour
+	case "day":
+		unit = 24 * time.Hour
+	}
+
+	if value > int64(math.MaxInt64/unit) {
+		return 0, fmt.Errorf("value (%d) is too large", value)
+	}
+
+	return time.Duration(value) * unit, nil
+}
+
+type cronSchedule struct {
+	minutes []bool
+	hours   []bool
+	months  []bool
+	years   []bool
+
+	// matchDay returns whether the schedule runs on a date, given the number of days in the date's month.
+	matchDay func(date time.Time, daysInMonth int) bool
+}
+
+// parseCronExpression parses the fields of an AWS cron expression:
+// minutes, hours, day-of-month, month, day-of-week and year.
+func parseCronExpression(s string) (*cronSchedule, error) {
+	fields := strings.Fields(s)
+	if n := len(fields); n != 6 {
+		return nil, fmt.Errorf("must have 6 fields (minutes hours day-of-month month day-of-week year), got %d", n)
+	}
+
+	var schedule cronSchedule
+	var err error
+
+	if schedule.minutes, err = parseCronField(fields[0], 0, 59, nil); err != nil {
+		return nil, fmt.Errorf("minutes (%s): %w", fields[0], err)
+	}
+	if schedule.hours, err = parseCronField(fields[1], 0, 23, nil); err != nil {
+		return nil, fmt.Errorf("hours (%s): %w", fields[1], err)
+	}
+	if schedule.months, err = parseCronField(fields[3], 1, 12, cronMonthNames); err != nil {
+		return nil, fmt.Errorf("month (%s): %w", fields[3], err)
+	}
+	if schedule.years, err = parseCronField(fields[5], cronMinYear, cronMaxYear, nil); err != nil {
+		return nil, fmt.Errorf("year (%s): %w", fields[5], err)
+	}
+
+	switch dayOfMonth, dayOfWeek := fields[2], fields[4]; {
+	case dayOfMonth == "?" && dayOfWeek == "?", dayOfMonth != "?" && dayOfWeek != "?":
+		return nil, errors.New("exactly one of day-of-month and day-of-week must be ?")
+	case dayOfWeek == "?":
+		if schedule.matchDay, err = parseCronDayOfMonth(dayOfMonth); err != nil {
+			return nil, fmt.Errorf("day-of-month (%s): %w", dayOfMonth, err)
+		}
+	default:
+		if schedule.matchDay, err = parseCronDayOfWeek(dayOfWeek); err != nil {
+			return nil, fmt.Errorf("day-of-week (%s): %w", dayOfWeek, err)
+		}
+	}
+
+	return &schedule, nil
+}
+
+// parseCronDayOfMonth parses a day-of-month field, which may also be `L` (the last day of the month)
+// or `nW` (the weekday nearest to day n of the month).
+func parseCronDayOfMonth(s string) (func(time.Time, int) bool, error) {
+	if s == "L" {
+		return func(date time.Time, daysInMonth int) bool {
+			return date.Day() == daysInMonth
+		}, nil
+	}
+
+	if v, ok := strings.CutSuffix(s, "W"); ok {
+		n, err := parseCronValue(v, 1, 31, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(date time.Time, daysInMonth int) bool {
+			if n > daysInMonth {
+				return false
+			}
+
+			// The nearest weekday doesn't cross into another month.
+			target := n
+			switch date.AddDate(0, 0, n-date.Day()).Weekday() {
+			case time.Saturday:
+				if n == 1 {
+					target = n + 2
+				} else {
+					target = n - 1
+				}
+			case time.Sunday:
+				if n == daysInMonth {
+					target = n - 2
+				} else {
+					target = n + 1
+				}
+			}
+
+			return date.Day() == target
+		}, nil
+	}
+
+	days, err := parseCronField(s, 1, 31, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(date time.Time, _ int) bool {
+		return days[date.Day()]
+	}, nil
+}
+
+// parseCronDayOfWeek parses a day-of-week field, which may also be `L` (Saturday), `nL` (the last day n of the month)
+// or `n#k` (the k-th day n of the month). Days of the week are numbered from 1 (Sunday) to 7 (Saturday).
+func parseCronDayOfWeek(s string) (func(time.Time, int) bool, error) {
+	if s == "L" {
+		s = "SAT"
+	}
+
+	if v, ok := strings.CutSuffix(s, "L"); ok {
+		n, err := parseCronValue(v, 1, 7, cronDayOfWeekNames)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(date time.Time, daysInMonth int) bool {
+			return cronDayOfWeek(date) == n && date.Day()+7 > daysInMonth
+		}, nil
+	}
+
+	if v, k, ok := strings.Cut(s, "#"); ok {
+		n, err := parseCronValue(v, 1, 7, cronDayOfWeekNames)
+		if err != nil {
+			return nil, err
+		}
+		nth, err := parseCronValue(k, 1, 5, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(date time.Time, _ int) bool {
+			return cronDayOfWeek(date) == n && (date.Day()-1)/7+1 == nth
+		}, nil
+	}
+
+	days, err := parseCronField(s, 1, 7, cronDayOfWeekNames)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(date time.Time, _ int) bool {
+		return days[cronDayOfWeek(date)]
+	}, nil
+}
+
+func cronDayOfWeek(date time.Time) int {
+	return int(date.Weekday()) + 1
+}
+
+// parseCronField parses a comma-separated list of values, ranges (`a-b`), wildcards (`*`) and increments
+// (`a/n`, `a-b/n` or `*/n`), returning a slice indexed by value.
+func parseCronField(s string, minValue, maxValue int, names map[string]int) ([]bool, error) {
+	values := make([]bool, maxValue+1)
+
+	for _, part := range strings.Split(s, ",") {
+		step := 0
+		if v, increment, ok := strings.Cut(part, "/"); ok {
+			n, err := strconv.Atoi(increment)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid increment (%s)", increment)
+			}
+			part, step = v, n
+		}
+
+		var lo, hi int
+		if part == "*" {
+			lo, hi = minValue, maxValue
+		} else if from, to, ok := strings.Cut(part, "-"); ok {
+			var err error
+			if lo, err = parseCronValue(from, minValue, maxValue, names); err != nil {
+				return nil, err
+			}
+			if hi, err = parseCronValue(to, minValue, maxValue, names); err != nil {
+				return nil, err
+			}
+			if lo > hi {
+				return nil, fmt.Errorf("invalid range (%s)", part)
+			}
+		} else {
+			var err error
+			if lo, err = parseCronValue(part, minValue, maxValue, names); err != nil {
+				return nil, err
+			}
+			hi = lo
+			if step > 0 {
+				hi = maxValue
+			}
+		}
+
+		for v := lo; v <= hi; v += max(step, 1) {
+			values[v] = true
+		}
+	}
+
+	return values, nil
+}
+
+func parseCronValue(s string, minValue, maxValue int, names map[string]int) (int, error) {
+	if v, ok := names[strings.ToUpper(s)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value (%s)", s)
+	}
+	if v < minValue || v > maxValue {
+		return 0, fmt.Errorf("value (%d) must be between %d and %d", v, minValue, maxValue)
+	}
+
+	return v, nil
+}
+
+// nextRuns returns up to count times, at or after start, at which the schedule runs in the specified location.
+// Local times skipped by daylight saving time transitions are skipped.
+func (c *cronSchedule) nextRuns(loc *time.Location, count int, start time.Time) []time.Time {
+	runs := make([]time.Time, 0, count)
+
+	start = start.In(loc)
+	startYear, startMonth, startDay := start.Date()
+
+	for year := max(startYear, cronMinYear); year <= cronMaxYear; year++ {
+		if !c.years[year] {
+			continue
+		}
+
+		for month := time.January; month <= time.December; month++ {
+			if !c.months[month] || (year == startYear && month < startMonth) {
+				continue
+			}
+
+			daysInMonth := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+			for day := 1; day <= daysInMonth; day++ {
+				if year == startYear && month == startMonth && day < startDay {
+					continue
+				}
+
+				if !c.matchDay(time.Date(year, month, day, 0, 0, 0, 0, time.UTC), daysInMonth) {
+					continue
+				}
+
+				for hour := 0; hour < 24; hour++ {
+					if !c.hours[hour] {
+						continue
+					}
+
+					for minute := 0; minute < 60; minute++ {
+						if !c.minutes[minute] {
+							continue
+						}
+
+						t := time.Date(year, month, day, hour, minute, 0, 0, loc)
+						if t.Hour() != hour || t.Minute() != minute || t.Before(start) {
+							continue
+						}
+
+						runs = append(runs, t)
+						if len(runs) == count {
+							return runs
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return runs
+}
@@ -0,0 +1,341 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+)
+
+func TestScheduleNextRunsFunction_cron(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleNextRunsFunctionConfig("cron(0 2 ? * TUE#2 *)", "Europe/Paris", 2, "2024-01-01T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `["2024-01-09T02:00:00+01:00","2024-02-13T02:00:00+01:00"]`),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleNextRunsFunction_rate(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleNextRunsFunctionConfigNullTimezone("rate(12 hours)", 3, "2024-01-01T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `["2024-01-01T00:00:00Z","2024-01-01T12:00:00Z","2024-01-02T00:00:00Z"]`),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleNextRunsFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testScheduleNextRunsFunctionConfigNullTimezone("cron(0 10 * * MON *)", 1, "2024-01-01T00:00:00Z"),
+				ExpectError: regexache.MustCompile(`exactly[\s\n]*one[\s\n]*of[\s\n]*day-of-month`),
+			},
+		},
+	})
+}
+
+func testScheduleNextRunsFunctionConfig(expression, timezone string, count int, start string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = jsonencode(provider::aws::schedule_next_runs(%[1]q, %[2]q, %[3]d, %[4]q))
+}
+`, expression, timezone, count, start)
+}
+
+func testScheduleNextRunsFunctionConfigNullTimezone(expression string, count int, start string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = jsonencode(provider::aws::schedule_next_runs(%[1]q, null, %[2]d, %[3]q))
+}
+`, expression, count, start)
+}
+
+func TestScheduleNextRuns(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		expression    string
+		timezone      string
+		count         int
+		start         string
+		expected      []string
+		expectedError string
+	}{
+		{
+			name:       "rate",
+			expression: "rate(5 minutes)",
+			count:      3,
+			start:      "2024-01-01T00:00:00Z",
+			expected:   []string{"2024-01-01T00:00:00Z", "2024-01-01T00:05:00Z", "2024-01-01T00:10:00Z"},
+		},
+		{
+			name:       "rate singular",
+			expression: "rate(1 day)",
+			count:      2,
+			start:      "2024-02-28T12:00:00Z",
+			expected:   []string{"2024-02-28T12:00:00Z", "2024-02-29T12:00:00Z"},
+		},
+		{
+			name:          "rate plural unit for 1",
+			expression:    "rate(1 minutes)",
+			count:         1,
+			start:         "2024-01-01T00:00:00Z",
+			expectedError: "rate expression (rate(1 minutes)): unit must be minute for a value of 1",
+		},
+		{
+			name:          "rate singular unit for 5",
+			expression:    "rate(5 hour)",
+			count:         1,
+			start:         "2024-01-01T00:00:00Z",
+			expectedError: "rate expression (rate(5 hour)): unit must be hours for a value greater than 1",
+		},
+		{
+			name:          "rate too large",
+			expression:    "rate(9999999999999 days)",
+			count:         1,
+			start:         "2024-01-01T00:00:00Z",
+			expectedError: "rate expression (rate(9999999999999 days)): value (9999999999999) is too large",
+		},
+		{
+			name:       "cron weekdays",
+			expression: "cron(0 10 ? * MON-FRI *)",
+			count:      3,
+			start:      "2024-01-05T09:00:00Z",
+			expected:   []string{"2024-01-05T10:00:00Z", "2024-01-08T10:00:00Z", "2024-01-09T10:00:00Z"},
+		},
+		{
+			name:       "cron increment",
+			expression: "cron(0/15 * * * ? *)",
+			count:      3,
+			start:      "2024-01-01T00:07:30Z",
+			expected:   []string{"2024-01-01T00:15:00Z", "2024-01-01T00:30:00Z", "2024-01-01T00:45:00Z"},
+		},
+		{
+			name:       "cron start inclusive",
+			expression: "cron(0 12 * * ? *)",
+			count:      2,
+			start:      "2024-01-01T12:00:00Z",
+			expected:   []string{"2024-01-01T12:00:00Z", "2024-01-02T12:00:00Z"},
+		},
+		{
+			name:       "cron list and range",
+			expression: "cron(0 8,20 1-2 JAN ? *)",
+			count:      5,
+			start:      "2024-01-01T00:00:00Z",
+			expected:   []string{"2024-01-01T08:00:00Z", "2024-01-01T20:00:00Z", "2024-01-02T08:00:00Z", "2024-01-02T20:00:00Z", "2025-01-01T08:00:00Z"},
+		},
+		{
+			name:       "cron last day of month",
+			expression: "cron(0 0 L * ? *)",
+			count:      3,
+			start:      "2024-01-15T00:00:00Z",
+			expected:   []string{"2024-01-31T00:00:00Z", "2024-02-29T00:00:00Z", "2024-03-31T00:00:00Z"},
+		},
+		{
+			name:       "cron nearest weekday",
+			expression: "cron(0 9 1W * ? *)",
+			count:      2,
+			start:      "2024-06-01T00:00:00Z",
+			expected:   []string{"2024-06-03T09:00:00Z", "2024-07-01T09:00:00Z"},
+		},
+		{
+			name:       "cron nearest weekday Sunday",
+			expression: "cron(0 9 15W 9 ? 2024)",
+			count:      1,
+			start:      "2024-01-01T00:00:00Z",
+			expected:   []string{"2024-09-16T09:00:00Z"},
+		},
+		{
+			name:       "cron nearest weekday end of month",
+			expression: "cron(0 9 30W 6 ? 2024)",
+			count:      1,
+			start:      "2024-01-01T00:00:00Z",
+			expected:   []string{"2024-06-28T09:00:00Z"},
+		},
+		{
+			name:       "cron nth day of week",
+			expression: "cron(0 2 ? * TUE#2 *)",
+			count:      2,
+			start:      "2024-01-01T00:00:00Z",
+			expected:   []string{"2024-01-09T02:00:00Z", "2024-02-13T02:00:00Z"},
+		},
+		{
+			name:       "cron last day of week in month",
+			expression: "cron(0 0 ? * 6L *)",
+			count:      2,
+			start:      "2024-01-01T00:00:00Z",
+			expected:   []string{"2024-01-26T00:00:00Z", "2024-02-23T00:00:00Z"},
+		},
+		{
+			name:       "cron year",
+			expression: "cron(0 0 1 1 ? 2025)",
+			count:      5,
+			start:      "2024-01-01T00:00:00Z",
+			expected:   []string{"2025-01-01T00:00:00Z"},
+		},
+		{
+			name:       "cron never",
+			expression: "cron(0 0 30 2 ? *)",
+			count:      1,
+			start:      "2024-01-01T00:00:00Z",
+			expected:   []string{},
+		},
+		{
+			name:       "cron daylight saving time",
+			expression: "cron(30 2 * * ? *)",
+			timezone:   "America/New_York",
+			count:      2,
+			start:      "2024-03-09T00:00:00-05:00",
+			expected:   []string{"2024-03-09T02:30:00-05:00", "2024-03-11T02:30:00-04:00"},
+		},
+		{
+			name:          "cron five fields",
+			expression:    "cron(0 10 * * ?)",
+			count:         1,
+			start:         "2024-01-01T00:00:00Z",
+			expectedError: "cron expression (cron(0 10 * * ?)): must have 6 fields (minutes hours day-of-month month day-of-week year), got 5",
+		},
+		{
+			name:          "cron both days",
+			expression:    "cron(0 10 * * MON *)",
+			count:         1,
+			start:         "2024-01-01T00:00:00Z",
+			expectedError: "cron expression (cron(0 10 * * MON *)): exactly one of day-of-month and day-of-week must be ?",
+		},
+		{
+			name:          "cron invalid minutes",
+			expression:    "cron(60 10 * * ? *)",
+			count:         1,
+			start:         "2024-01-01T00:00:00Z",
+			expectedError: "cron expression (cron(60 10 * * ? *)): minutes (60): value (60) must be between 0 and 59",
+		},
+		{
+			name:          "cron invalid range",
+			expression:    "cron(0 10 ? * FRI-MON *)",
+			count:         1,
+			start:         "2024-01-01T00:00:00Z",
+			expectedError: "cron expression (cron(0 10 ? * FRI-MON *)): day-of-week (FRI-MON): invalid range (FRI-MON)",
+		},
+		{
+			name:          "cron invalid nth",
+			expression:    "cron(0 10 ? * MON#6 *)",
+			count:         1,
+			start:         "2024-01-01T00:00:00Z",
+			expectedError: "cron expression (cron(0 10 ? * MON#6 *)): day-of-week (MON#6): value (6) must be between 1 and 5",
+		},
+		{
+			name:       "at",
+			expression: "at(2024-06-01T12:00:00)",
+			timezone:   "Europe/Paris",
+			count:      3,
+			start:      "2024-01-01T00:00:00Z",
+			expected:   []string{"2024-06-01T12:00:00+02:00"},
+		},
+		{
+			name:       "at before start",
+			expression: "at(2023-06-01T12:00:00)",
+			count:      1,
+			start:      "2024-01-01T00:00:00Z",
+			expected:   []string{},
+		},
+		{
+			name:          "unknown",
+			expression:    "every(5 minutes)",
+			count:         1,
+			start:         "2024-01-01T00:00:00Z",
+			expectedError: "schedule expression (every(5 minutes)) must be a rate(), cron() or at() expression",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			loc, err := tffunction.LoadScheduleLocation(testCase.timezone)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			start, err := time.Parse(time.RFC3339, testCase.start)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			runs, err := tffunction.ScheduleNextRuns(testCase.expression, loc, testCase.count, start)
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error %q, got none", testCase.expectedError)
+				}
+				if got, want := err.Error(), testCase.expectedError; got != want {
+					t.Errorf("unexpected error: got %q, want %q", got, want)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got := make([]string, 0, len(runs))
+			for _, run := range runs {
+				got = append(got, run.In(loc).Format(time.RFC3339))
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestLoadScheduleLocation(t *testing.T) {
+	t.Parallel()
+
+	if _, err := tffunction.LoadScheduleLocation("Local"); err == nil {
+		t.Error("expected error for Local, got none")
+	}
+	if _, err := tffunction.LoadScheduleLocation("Mars/Olympus_Mons"); err == nil {
+		t.Error("expected error for unknown time zone, got none")
+	}
+}
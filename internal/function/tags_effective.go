@@ -0,0 +1,293 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+var _ function.Function = tagsEffectiveFunction{}
+
+func NewTagsEffectiveFunction() function.Function {
+	return &tagsEffectiveFunction{}
+}
+
+// Provider-defined functions cannot read the provider configuration, so the
+// default_tags and ignore_tags configuration is passed as arguments.
+type tagsEffectiveFunction struct{}
+
+func (f tagsEffectiveFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tags_effective"
+}
+
+func (f tagsEffectiveFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "tags_effective Function",
+		MarkdownDescription: "Returns the tags that a resource's `tags_all` attribute will contain, merging `default_tags` " +
+			"with the resource's tags and removing tags matched by `ignore_tags` and tags with the `aws:` prefix",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				AllowNullValue:      true,
+				ElementType:         types.StringType,
+				Name:                "tags",
+				MarkdownDescription: "Resource tags",
+			},
+			function.DynamicParameter{
+				AllowNullValue:      true,
+				Name:                "default_tags",
+				MarkdownDescription: "Object with the same attributes as the provider's `default_tags` configuration block, or `null`",
+			},
+			function.DynamicParameter{
+				AllowNullValue:      true,
+				Name:                "ignore_tags",
+				MarkdownDescription: "Object with the same attributes as the provider's `ignore_tags` configuration block, or `null`",
+			},
+			function.StringParameter{
+				AllowNullValue:      true,
+				Name:                "resource_type",
+				MarkdownDescription: "Resource type, e.g. `aws_s3_bucket`, used to scope `default_tags`, or `null`",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f tagsEffectiveFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var tagsArg types.Map
+	var defaultTagsArg, ignoreTagsArg types.Dynamic
+	var resourceType types.String
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &tagsArg, &defaultTagsArg, &ignoreTagsArg, &resourceType))
+	if resp.Error != nil {
+		return
+	}
+
+	defaultConfig, err := expandTagsEffectiveDefaultConfig(ctx, defaultTagsArg.UnderlyingValue())
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("default_tags: %s", err)))
+		return
+	}
+
+	ignoreConfig, err := expandTagsEffectiveIgnoreConfig(ctx, ignoreTagsArg.UnderlyingValue())
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("ignore_tags: %s", err)))
+		return
+	}
+
+	tags, err := expandDynamicStringMap(tagsArg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("tags: %s", err)))
+		return
+	}
+
+	result, d := types.MapValueFrom(ctx, types.StringType, tagsEffective(ctx, tags, defaultConfig, ignoreConfig, resourceType.ValueString()))
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// tagsEffective returns a resource's tags_all, as computed by the provider for the specified default_tags and ignore_tags.
+func tagsEffective(ctx context.Context, tags map[string]string, defaultConfig *tftags.DefaultConfig, ignoreConfig *tftags.IgnoreConfig, resourceType string) map[string]string {
+	if resourceType != "" {
+		defaultConfig = defaultConfig.ForResourceType(resourceType)
+	}
+
+	return defaultConfig.MergeTags(tftags.New(ctx, tags)).IgnoreConfig(ignoreConfig).IgnoreAWS().Map()
+}
+
+func expandTagsEffectiveDefaultConfig(ctx context.Context, v attr.Value) (*tftags.DefaultConfig, error) {
+	attrs, err := expandDynamicObject(v)
+	if err != nil || attrs == nil {
+		return nil, err
+	}
+
+	defaultConfig := &tftags.DefaultConfig{}
+
+	for k, v := range attrs {
+		switch k {
+		case "tags":
+			tags, err := expandDynamicStringMap(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			if len(tags) > 0 {
+				defaultConfig.Tags = tftags.New(ctx, tags)
+			}
+		case "exclude_resource_types", "include_resource_types":
+			patterns, err := expandDynamicStrings(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			if err := validateGlobs(patterns); err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			if k == "exclude_resource_types" {
+				defaultConfig.ExcludeResourceTypes = patterns
+			} else {
+				defaultConfig.IncludeResourceTypes = patterns
+			}
+		default:
+			return nil, fmt.Errorf("unsupported attribute %q", k)
+		}
+	}
+
+	return defaultConfig, nil
+}
+
+func expandTagsEffectiveIgnoreConfig(ctx context.Context, v attr.Value) (*tftags.IgnoreConfig, error) {
+	attrs, err := expandDynamicObject(v)
+	if err != nil || attrs == nil {
+		return nil, err
+	}
+
+	ignoreConfig := &tftags.IgnoreConfig{}
+
+	for k, v := range attrs {
+		values, err := expandDynamicStrings(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+		if len(values) == 0 {
+			continue
+		}
+
+		switch k {
+		case "keys":
+			ignoreConfig.Keys = tftags.New(ctx, values)
+		case "key_prefixes":
+			ignoreConfig.KeyPrefixes = tftags.New(ctx, values)
+		case "key_globs":
+			if err := validateGlobs(values); err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			ignoreConfig.KeyGlobs = values
+		case "key_patterns":
+			for _, v := range values {
+				re, err := regexp.Compile(v)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", k, err)
+				}
+				ignoreConfig.KeyPatterns = append(ignoreConfig.KeyPatterns, re)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported attribute %q", k)
+		}
+	}
+
+	return ignoreConfig, nil
+}
+
+func validateGlobs(patterns []string) error {
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("%q: %w", pattern, err)
+		}
+	}
+
+	return nil
+}
+
+// expandDynamicObject returns the attributes of an object or map value, or nil if the value is null.
+func expandDynamicObject(v attr.Value) (map[string]attr.Value, error) {
+	if v == nil || v.IsNull() {
+		return nil, nil
+	}
+
+	switch v := v.(type) {
+	case basetypes.ObjectValue:
+		return v.Attributes(), nil
+	case basetypes.MapValue:
+		return v.Elements(), nil
+	default:
+		return nil, errors.New("must be an object")
+	}
+}
+
+// expandDynamicStrings returns the elements of a list, set or tuple of strings, ignoring null elements.
+func expandDynamicStrings(v attr.Value) ([]string, error) {
+	if v == nil || v.IsNull() {
+		return nil, nil
+	}
+
+	var elems []attr.Value
+
+	switch v := v.(type) {
+	case basetypes.ListValue:
+		elems = v.Elements()
+	case basetypes.SetValue:
+		elems = v.Elements()
+	case basetypes.TupleValue:
+		elems = v.Elements()
+	default:
+		return nil, errors.New("must be a list of strings")
+	}
+
+	var result []string
+	for _, elem := range elems {
+		if elem.IsNull() {
+			continue
+		}
+
+		s, ok := dynamicString(elem)
+		if !ok {
+			return nil, errors.New("must be a list of strings")
+		}
+		result = append(result, s)
+	}
+
+	return result, nil
+}
+
+// expandDynamicStringMap returns the elements of a map or object of strings, ignoring null elements.
+func expandDynamicStringMap(v attr.Value) (map[string]string, error) {
+	attrs, err := expandDynamicObject(v)
+	if err != nil {
+		return nil, errors.New("must be a map of strings")
+	}
+
+	result := make(map[string]string, len(attrs))
+	for k, v := range attrs {
+		if v.IsNull() {
+			continue
+		}
+
+		s, ok := dynamicString(v)
+		if !ok {
+			return nil, errors.New("must be a map of strings")
+		}
+		result[k] = s
+	}
+
+	return result, nil
+}
+
+// dynamicString returns a string, number or bool value as a string, as Terraform's tostring function does.
+func dynamicString(v attr.Value) (string, bool) {
+	switch v := v.(type) {
+	case basetypes.StringValue:
+		return v.ValueString(), true
+	case basetypes.NumberValue:
+		return v.ValueBigFloat().Text('f', -1), true
+	case basetypes.BoolValue:
+		return strconv.FormatBool(v.ValueBool()), true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestTagsEffectiveFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTagsEffectiveFunctionConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Environment":"prod","Name":"example","Owner":"data"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestTagsEffectiveFunction_nullConfig(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTagsEffectiveFunctionConfig_nullConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Name":"example"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestTagsEffectiveFunction_invalidIgnoreTags(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testTagsEffectiveFunctionConfig_invalidIgnoreTags(),
+				ExpectError: regexache.MustCompile(`unsupported[\s\n]*attribute[\s\n]*"key"`),
+			},
+		},
+	})
+}
+
+func testTagsEffectiveFunctionConfig_basic() string {
+	return `
+locals {
+  default_tags = {
+    tags = {
+      Environment = "prod"
+      Owner       = "platform"
+      Team        = "core"
+    }
+    exclude_resource_types = ["aws_autoscaling_*"]
+  }
+
+  ignore_tags = {
+    keys = ["Team"]
+  }
+}
+
+output "test" {
+  value = jsonencode(provider::aws::tags_effective({
+    Name            = "example"
+    Owner           = "data"
+    "aws:createdBy" = "automation"
+  }, local.default_tags, local.ignore_tags, "aws_s3_bucket"))
+}
+`
+}
+
+func testTagsEffectiveFunctionConfig_nullConfig() string {
+	return `
+output "test" {
+  value = jsonencode(provider::aws::tags_effective({
+    Name = "example"
+  }, null, null, null))
+}
+`
+}
+
+func testTagsEffectiveFunctionConfig_invalidIgnoreTags() string {
+	return `
+output "test" {
+  value = provider::aws::tags_effective({
+    Name = "example"
+  }, null, { key = ["Team"] }, null)
+}
+`
+}
+
+func TestTagsEffective(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultConfig := &tftags.DefaultConfig{
+		Tags: tftags.New(ctx, map[string]string{
+			"Environment": "prod",
+			"Owner":       "platform",
+		}),
+		ExcludeResourceTypes: []string{"aws_autoscaling_*"},
+	}
+
+	testCases := []struct {
+		name          string
+		tags          map[string]string
+		defaultConfig *tftags.DefaultConfig
+		ignoreConfig  *tftags.IgnoreConfig
+		resourceType  string
+		expected      map[string]string
+	}{
+		{
+			name: "resource tags only",
+			tags: map[string]string{
+				"Name": "example",
+			},
+			expected: map[string]string{
+				"Name": "example",
+			},
+		},
+		{
+			name: "default tags merged",
+			tags: map[string]string{
+				"Name":  "example",
+				"Owner": "data",
+			},
+			defaultConfig: defaultConfig,
+			expected: map[string]string{
+				"Environment": "prod",
+				"Name":        "example",
+				"Owner":       "data",
+			},
+		},
+		{
+			name: "resource type excluded",
+			tags: map[string]string{
+				"Name": "example",
+			},
+			defaultConfig: defaultConfig,
+			resourceType:  "aws_autoscaling_group",
+			expected: map[string]string{
+				"Name": "example",
+			},
+		},
+		{
+			name: "resource type not excluded",
+			tags: map[string]string{
+				"Name": "example",
+			},
+			defaultConfig: defaultConfig,
+			resourceType:  "aws_s3_bucket",
+			expected: map[string]string{
+				"Environment": "prod",
+				"Name":        "example",
+				"Owner":       "platform",
+			},
+		},
+		{
+			name: "ignored",
+			tags: map[string]string{
+				"Name":                "example",
+				"kubernetes.io/x":     "owned",
+				"team/data/owner":     "alice",
+				"CreatedBy":           "automation",
+				"automation-managed":  "true",
+				"aws:cloudformation:": "stack",
+			},
+			defaultConfig: defaultConfig,
+			ignoreConfig: &tftags.IgnoreConfig{
+				Keys:        tftags.New(ctx, []string{"Owner"}),
+				KeyPrefixes: tftags.New(ctx, []string{"kubernetes.io/"}),
+				KeyGlobs:    []string{"team/*/owner"},
+				KeyPatterns: []*regexp.Regexp{regexache.MustCompile(`-managed$`)},
+			},
+			expected: map[string]string{
+				"CreatedBy":   "automation",
+				"Environment": "prod",
+				"Name":        "example",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tffunction.TagsEffective(ctx, testCase.tags, testCase.defaultConfig, testCase.ignoreConfig, testCase.resourceType)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
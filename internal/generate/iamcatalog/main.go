@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+const (
+	// The AWS Service Authorization Reference, in machine-readable form.
+	// https://docs.aws.amazon.com/service-authorization/latest/reference/service-reference.html
+	serviceReferenceURL = "https://servicereference.us-east-1.amazonaws.com/"
+)
+
+// globalConditionKeys are the AWS global condition context keys, which the Service Authorization Reference doesn't list.
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_condition-keys.html
+var globalConditionKeys = []string{
+	"aws:CalledVia",
+	"aws:CalledViaFirst",
+	"aws:CalledViaLast",
+	"aws:ChatbotSourceArn",
+	"aws:CurrentTime",
+	"aws:Ec2InstanceSourcePrivateIPv4",
+	"aws:Ec2InstanceSourceVpc",
+	"aws:EpochTime",
+	"aws:FederatedProvider",
+	"aws:MultiFactorAuthAge",
+	"aws:MultiFactorAuthPresent",
+	"aws:PrincipalAccount",
+	"aws:PrincipalArn",
+	"aws:PrincipalIsAWSService",
+	"aws:PrincipalOrgID",
+	"aws:PrincipalOrgPaths",
+	"aws:PrincipalServiceName",
+	"aws:PrincipalServiceNamesList",
+	"aws:PrincipalTag/${TagKey}",
+	"aws:PrincipalType",
+	"aws:Referer",
+	"aws:RequestTag/${TagKey}",
+	"aws:RequestedRegion",
+	"aws:ResourceAccount",
+	"aws:ResourceOrgID",
+	"aws:ResourceOrgPaths",
+	"aws:ResourceTag/${TagKey}",
+	"aws:SecureTransport",
+	"aws:SourceAccount",
+	"aws:SourceArn",
+	"aws:SourceIdentity",
+	"aws:SourceIp",
+	"aws:SourceOrgID",
+	"aws:SourceOrgPaths",
+	"aws:SourceVpc",
+	"aws:SourceVpcArn",
+	"aws:SourceVpce",
+	"aws:TagKeys",
+	"aws:TokenIssueTime",
+	"aws:UserAgent",
+	"aws:ViaAWSService",
+	"aws:VpcSourceIp",
+	"aws:userid",
+	"aws:username",
+}
+
+// serviceReferenceIndexEntry is an entry in the Service Authorization Reference index.
+type serviceReferenceIndexEntry struct {
+	Service string `json:"service"`
+	URL     string `json:"url"`
+}
+
+// serviceReference is the Service Authorization Reference of a service.
+type serviceReference struct {
+	Version string `json:"Version"`
+	Actions []struct {
+		Name string `json:"Name"`
+	} `json:"Actions"`
+	ConditionKeys []struct {
+		Name string `json:"Name"`
+	} `json:"ConditionKeys"`
+	Resources []struct {
+		Name       string   `json:"Name"`
+		ARNFormats []string `json:"ARNFormats"`
+	} `json:"Resources"`
+}
+
+func main() {
+	const (
+		filename = `iam_catalog.json`
+	)
+	g := common.NewGenerator()
+
+	g.Infof("Generating names/data/%s", filename)
+
+	var index []serviceReferenceIndexEntry
+	if err := getJSON(serviceReferenceURL, &index); err != nil {
+		g.Fatalf("reading Service Authorization Reference index: %s", err)
+	}
+
+	catalog := data.IAMCatalog{
+		Version:             time.Now().UTC().Format(time.DateOnly),
+		GlobalConditionKeys: globalConditionKeys,
+	}
+
+	for _, entry := range index {
+		var ref serviceReference
+		if err := getJSON(entry.URL, &ref); err != nil {
+			g.Fatalf("reading Service Authorization Reference (%s): %s", entry.Service, err)
+		}
+
+		service := data.IAMCatalogService{
+			Prefix:        entry.Service,
+			Version:       ref.Version,
+			Actions:       []string{},
+			ResourceTypes: []data.IAMCatalogResourceType{},
+			ConditionKeys: []string{},
+		}
+
+		for _, v := range ref.Actions {
+			service.Actions = append(service.Actions, v.Name)
+		}
+		for _, v := range ref.Resources {
+			service.ResourceTypes = append(service.ResourceTypes, data.IAMCatalogResourceType{
+				Name:       v.Name,
+				ARNFormats: v.ARNFormats,
+			})
+		}
+		for _, v := range ref.ConditionKeys {
+			service.ConditionKeys = append(service.ConditionKeys, v.Name)
+		}
+
+		slices.Sort(service.Actions)
+		slices.SortFunc(service.ResourceTypes, func(a, b data.IAMCatalogResourceType) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+		slices.Sort(service.ConditionKeys)
+
+		catalog.Services = append(catalog.Services, service)
+	}
+
+	slices.SortFunc(catalog.Services, func(a, b data.IAMCatalogService) int {
+		return strings.Compare(a.Prefix, b.Prefix)
+	})
+
+	body, err := json.MarshalIndent(catalog, "", "  ")
+	if err != nil {
+		g.Fatalf("encoding IAM catalog: %s", err)
+	}
+
+	d := g.NewUnformattedFileDestination(filename)
+
+	if err := d.WriteBytes(append(body, '\n')); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+func getJSON(url string, v any) error {
+	resp, err := http.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
@@ -16,6 +16,7 @@ import (
 	"os"
 	"slices"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/YakDriver/regexache"
@@ -122,6 +123,8 @@ func main() {
 type ResourceDatum struct {
 	FactoryName             string
 	Name                    string // Friendly name (without service name), e.g. "Topic", not "SNS Topic"
+	Region                  bool
+	RegionOverrideEnabled   bool
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
@@ -206,12 +209,27 @@ func (v *visitor) processFile(file *ast.File) {
 func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 	v.functionName = funcDecl.Name.Name
 
-	// Look first for tagging annotations.
+	// Look first for tagging and Region annotations.
 	d := ResourceDatum{}
 
 	for _, line := range funcDecl.Doc.List {
 		line := line.Text
 
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Region" {
+			args := common.ParseArgs(m[3])
+
+			d.Region = true
+			d.RegionOverrideEnabled = true
+
+			if attr, ok := args.Keyword["overrideEnabled"]; ok {
+				if b, err := strconv.ParseBool(attr); err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid Region overrideEnabled value (%s): %s", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					d.RegionOverrideEnabled = b
+				}
+			}
+		}
+
 		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Tags" {
 			args := common.ParseArgs(m[3])
 
@@ -282,7 +300,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "Region", "Tags":
 				// Handled above.
 			case "Testing":
 				// Ignored.
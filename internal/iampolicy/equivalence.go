@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+)
+
+// StringsEquivalent returns whether two JSON IAM policy documents are semantically equivalent.
+// Empty documents ("" and "{}") are equivalent.
+func StringsEquivalent(s1, s2 string) bool {
+	if strings.TrimSpace(s1) == "" && strings.TrimSpace(s2) == "" {
+		return true
+	}
+
+	if strings.TrimSpace(s1) == "{}" && strings.TrimSpace(s2) == "" {
+		return true
+	}
+
+	if strings.TrimSpace(s1) == "" && strings.TrimSpace(s2) == "{}" {
+		return true
+	}
+
+	if strings.TrimSpace(s1) == "{}" && strings.TrimSpace(s2) == "{}" {
+		return true
+	}
+
+	equivalent, err := awspolicy.PoliciesAreEquivalent(s1, s2)
+	if err != nil {
+		return false
+	}
+
+	return equivalent
+}
+
+// LegacyNormalize returns a "normalized" JSON policy document except
+// the Version element is first in the JSON as required by AWS in many places.
+// Version not being first is one reason for this error:
+// MalformedPolicyDocument: The policy failed legacy parsing
+func LegacyNormalize(policy interface{}) (string, error) {
+	if policy == nil || policy.(string) == "" {
+		return "", nil
+	}
+
+	np, err := structure.NormalizeJsonString(policy)
+	if err != nil {
+		return policy.(string), fmt.Errorf("legacy policy (%s) is invalid JSON: %w", policy, err)
+	}
+
+	m := regexache.MustCompile(`(?s)^(\{\n?)(.*?)(,\s*)?(  )?("Version":\s*"2012-10-17")(,)?(\n)?(.*?)(\})`)
+
+	n := m.ReplaceAllString(np, `$1$4$5$3$2$6$7$8$9`)
+
+	_, err = structure.NormalizeJsonString(n)
+	if err != nil {
+		return policy.(string), fmt.Errorf("LegacyPolicyNormalize created a policy (%s) that is invalid JSON: %w", n, err)
+	}
+
+	return n, nil
+}
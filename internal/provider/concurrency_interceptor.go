@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// serviceConcurrencyInterceptor limits the number of in-flight CRUD operations for a service.
+// It must be the last interceptor in the chain so that no subsequent Before interceptor can short circuit and leak an acquired slot.
+type serviceConcurrencyInterceptor struct{}
+
+func (r serviceConcurrencyInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	c, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return ctx, diags
+	}
+
+	semaphore, ok := c.ServiceSemaphore(ctx, inContext.ServicePackageName)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		tflog.Debug(ctx, "Waiting for service concurrency slot", map[string]any{
+			"tf_aws.service_package": inContext.ServicePackageName,
+		})
+		// Cancellation, e.g. on interrupt, stops waiting.
+		if err := semaphore.WaitContext(ctx); err != nil {
+			return ctx, sdkdiag.AppendErrorf(diags, "waiting for %s service concurrency slot: %s", inContext.ServicePackageName, err)
+		}
+	case Finally:
+		semaphore.Notify()
+	}
+
+	return ctx, diags
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// limitServiceConcurrency acquires a service concurrency slot Before and releases it Finally.
+func limitServiceConcurrency(ctx context.Context, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok || meta == nil {
+		return ctx, diags
+	}
+
+	semaphore, ok := meta.ServiceSemaphore(ctx, inContext.ServicePackageName)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		tflog.Debug(ctx, "Waiting for service concurrency slot", map[string]any{
+			"tf_aws.service_package": inContext.ServicePackageName,
+		})
+		// Cancellation, e.g. on interrupt, stops waiting.
+		if err := semaphore.WaitContext(ctx); err != nil {
+			diags.AddError(fmt.Sprintf("waiting for %s service concurrency slot", inContext.ServicePackageName), err.Error())
+		}
+	case Finally:
+		semaphore.Notify()
+	}
+
+	return ctx, diags
+}
+
+// serviceConcurrencyDataSourceInterceptor limits the number of in-flight data source reads for a service.
+// It must be the last interceptor in the chain so that no subsequent Before interceptor can short circuit and leak an acquired slot.
+type serviceConcurrencyDataSourceInterceptor struct{}
+
+func (r serviceConcurrencyDataSourceInterceptor) read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return limitServiceConcurrency(ctx, meta, when, diags)
+}
+
+// serviceConcurrencyResourceInterceptor limits the number of in-flight resource CRUD operations for a service.
+// It must be the last interceptor in the chain so that no subsequent Before interceptor can short circuit and leak an acquired slot.
+type serviceConcurrencyResourceInterceptor struct{}
+
+func (r serviceConcurrencyResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return limitServiceConcurrency(ctx, meta, when, diags)
+}
+
+func (r serviceConcurrencyResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return limitServiceConcurrency(ctx, meta, when, diags)
+}
+
+func (r serviceConcurrencyResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return limitServiceConcurrency(ctx, meta, when, diags)
+}
+
+func (r serviceConcurrencyResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return limitServiceConcurrency(ctx, meta, when, diags)
+}
@@ -18,8 +18,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -67,6 +69,13 @@ type resourceInterceptor interface {
 	delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
 }
 
+// A resourceModifyPlanInterceptor is a resource interceptor that is also invoked for a ModifyPlan call,
+// after the resource's own ModifyPlan method.
+type resourceModifyPlanInterceptor interface {
+	// modifyPlan is invoked for a ModifyPlan call.
+	modifyPlan(context.Context, resource.ModifyPlanRequest, *resource.ModifyPlanResponse, *conns.AWSClient, diag.Diagnostics) diag.Diagnostics
+}
+
 type resourceInterceptors []resourceInterceptor
 
 type resourceInterceptorFunc[Request resourceCRUDRequest, Response resourceCRUDResponse] interceptorFunc[Request, Response]
@@ -190,23 +199,51 @@ func interceptedResourceHandler[Request resourceCRUDRequest, Response resourceCR
 	}
 }
 
+// startSpan starts a tracing span for a CRUD handler invocation.
+func startSpan(ctx context.Context, operation string) (context.Context, *tracing.Span) {
+	var typeName string
+	attributes := []tracing.Attribute{
+		tracing.String("tf.operation", operation),
+	}
+	if v, ok := conns.FromContext(ctx); ok {
+		typeName = v.TypeName
+		attributes = append(attributes,
+			tracing.String("tf.resource_type", v.TypeName),
+			tracing.String("tf.service_package", v.ServicePackageName),
+			tracing.Bool("tf.data_source", v.IsDataSource),
+		)
+	}
+
+	return tracing.Start(ctx, typeName+" "+operation, tracing.SpanKindInternal, attributes...)
+}
+
+// endSpan ends a CRUD handler invocation's tracing span.
+func endSpan(span *tracing.Span, diags diag.Diagnostics) {
+	if v := diags.Errors(); len(v) > 0 {
+		span.SetError(v[0].Summary())
+	}
+	span.End()
+}
+
 // contextFunc augments Context.
 type contextFunc func(context.Context, *conns.AWSClient) context.Context
 
 // wrappedDataSource represents an interceptor dispatcher for a Plugin Framework data source.
 type wrappedDataSource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
-	bootstrapContext contextFunc
-	inner            datasource.DataSourceWithConfigure
-	interceptors     dataSourceInterceptors
-	meta             *conns.AWSClient
+	bootstrapContext        contextFunc
+	inner                   datasource.DataSourceWithConfigure
+	interceptors            dataSourceInterceptors
+	isRegionOverrideEnabled bool
+	meta                    *conns.AWSClient
 }
 
-func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors) datasource.DataSourceWithConfigure {
+func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors, isRegionOverrideEnabled bool) datasource.DataSourceWithConfigure {
 	return &wrappedDataSource{
-		bootstrapContext: bootstrapContext,
-		inner:            inner,
-		interceptors:     interceptors,
+		bootstrapContext:        bootstrapContext,
+		inner:                   inner,
+		interceptors:            interceptors,
+		isRegionOverrideEnabled: isRegionOverrideEnabled,
 	}
 }
 
@@ -218,6 +255,10 @@ func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.Met
 func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.isRegionOverrideEnabled {
+		response.Schema.Attributes[names.AttrRegion] = dataSourceRegionAttribute()
+	}
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
@@ -226,7 +267,9 @@ func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadReq
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx, span := startSpan(ctx, "Read")
 	diags := interceptedDataSourceReadHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
+	endSpan(span, diags)
 	response.Diagnostics = diags
 }
 
@@ -251,17 +294,19 @@ func (r tagsDataSourceInterceptor) read(ctx context.Context, request datasource.
 // wrappedResource represents an interceptor dispatcher for a Plugin Framework resource.
 type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
-	bootstrapContext contextFunc
-	inner            resource.ResourceWithConfigure
-	interceptors     resourceInterceptors
-	meta             *conns.AWSClient
+	bootstrapContext        contextFunc
+	inner                   resource.ResourceWithConfigure
+	interceptors            resourceInterceptors
+	isRegionOverrideEnabled bool
+	meta                    *conns.AWSClient
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
+func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, isRegionOverrideEnabled bool) resource.ResourceWithConfigure {
 	return &wrappedResource{
-		bootstrapContext: bootstrapContext,
-		inner:            inner,
-		interceptors:     interceptors,
+		bootstrapContext:        bootstrapContext,
+		inner:                   inner,
+		interceptors:            interceptors,
+		isRegionOverrideEnabled: isRegionOverrideEnabled,
 	}
 }
 
@@ -273,6 +318,10 @@ func (w *wrappedResource) Metadata(ctx context.Context, request resource.Metadat
 func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.isRegionOverrideEnabled {
+		response.Schema.Attributes[names.AttrRegion] = resourceRegionAttribute()
+	}
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
@@ -281,7 +330,9 @@ func (w *wrappedResource) Create(ctx context.Context, request resource.CreateReq
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx, span := startSpan(ctx, "Create")
 	diags := interceptedResourceHandler(w.interceptors.create(), f, w.meta)(ctx, request, response)
+	endSpan(span, diags)
 	response.Diagnostics = diags
 }
 
@@ -291,7 +342,9 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx, span := startSpan(ctx, "Read")
 	diags := interceptedResourceHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
+	endSpan(span, diags)
 	response.Diagnostics = diags
 }
 
@@ -301,7 +354,9 @@ func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateReq
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx, span := startSpan(ctx, "Update")
 	diags := interceptedResourceHandler(w.interceptors.update(), f, w.meta)(ctx, request, response)
+	endSpan(span, diags)
 	response.Diagnostics = diags
 }
 
@@ -311,7 +366,9 @@ func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteReq
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx, span := startSpan(ctx, "Delete")
 	diags := interceptedResourceHandler(w.interceptors.delete(), f, w.meta)(ctx, request, response)
+	endSpan(span, diags)
 	response.Diagnostics = diags
 }
 
@@ -326,8 +383,25 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+
+		// Support import IDs of the form `<id>@<region>`.
+		var region string
+		if w.isRegionOverrideEnabled {
+			if id, overrideRegion, ok := verify.ParseRegionalImportID(request.ID); ok {
+				request.ID, region = id, overrideRegion
+				response.Diagnostics.Append(setOverrideRegion(ctx, w.meta, region)...)
+				if response.Diagnostics.HasError() {
+					return
+				}
+			}
+		}
+
 		v.ImportState(ctx, request, response)
 
+		if region != "" && !response.Diagnostics.HasError() {
+			response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), region)...)
+		}
+
 		return
 	}
 
@@ -338,9 +412,32 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+
+	if w.isRegionOverrideEnabled {
+		planRegion(ctx, w.meta, request, response)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
 		v.ModifyPlan(ctx, request, response)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	for _, v := range w.interceptors {
+		if v, ok := v.(resourceModifyPlanInterceptor); ok {
+			response.Diagnostics = v.modifyPlan(ctx, request, response, w.meta, response.Diagnostics)
+
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
 	}
 }
 
@@ -430,6 +527,49 @@ func (r tagsResourceInterceptor) create(ctx context.Context, request resource.Cr
 	return ctx, diags
 }
 
+// modifyPlan enforces any provider configured required_tags.
+func (r tagsResourceInterceptor) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient, diags diag.Diagnostics) diag.Diagnostics {
+	if r.tags == nil || meta == nil || meta.RequiredTagsConfig == nil {
+		return diags
+	}
+
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() {
+		return diags
+	}
+
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return diags
+	}
+
+	var planTags fwtypes.Map
+	diags.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrTags), &planTags)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	// Tags are validated once they are known.
+	if planTags.IsUnknown() {
+		return diags
+	}
+	for _, v := range planTags.Elements() {
+		if v.IsUnknown() {
+			return diags
+		}
+	}
+
+	// Merge the resource's configured tags with any provider configured default_tags.
+	tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, planTags))
+
+	if err := meta.RequiredTagsConfig.Validate(tags); err != nil {
+		diags.AddAttributeError(path.Root(names.AttrTags), "Required tags", err.Error())
+	}
+
+	return diags
+}
+
 func (r tagsResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	if r.tags == nil {
 		return ctx, diags
@@ -515,6 +655,39 @@ func (r tagsResourceInterceptor) read(ctx context.Context, request resource.Read
 
 		apiTags := tagsInContext.TagsOut.UnwrapOrDefault()
 
+		// Report any tags changed outside of Terraform since they were last recorded in state.
+		if tagsInContext.TagsOut.IsSome() && !request.State.Raw.IsNull() {
+			var oldTags, oldTagsAll fwtypes.Map
+
+			diags.Append(request.State.GetAttribute(ctx, path.Root(names.AttrTags), &oldTags)...)
+			diags.Append(request.State.GetAttribute(ctx, path.Root(names.AttrTagsAll), &oldTagsAll)...)
+
+			if diags.HasError() {
+				return ctx, diags
+			}
+
+			if !oldTagsAll.IsNull() && !oldTagsAll.IsUnknown() {
+				drift := apiTags.IgnoreSystem(inContext.ServicePackageName).DriftFramework(tftags.New(ctx, oldTagsAll), tagsInContext.DefaultConfig, tagsInContext.IgnoreConfig, tftags.New(ctx, oldTags))
+
+				if len(drift) > 0 {
+					description := fmt.Sprintf("%s %s", serviceName, resourceName)
+					if identifierAttribute := r.tags.IdentifierAttribute; identifierAttribute != "" {
+						var identifier string
+
+						diags.Append(request.State.GetAttribute(ctx, path.Root(identifierAttribute), &identifier)...)
+
+						if diags.HasError() {
+							return ctx, diags
+						}
+
+						description = fmt.Sprintf("%s (%s)", description, identifier)
+					}
+
+					diags.AddWarning(tftags.DriftSummary, drift.Detail(description))
+				}
+			}
+		}
+
 		// AWS APIs often return empty lists of tags when none have been configured.
 		stateTags := tftags.Null
 		// Remove any provider configured ignore_tags and system tags from those returned from the service API.
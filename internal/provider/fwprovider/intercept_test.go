@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestTagsResourceInterceptorModifyPlan(t *testing.T) {
+	t.Parallel()
+
+	s := resourceschema.Schema{
+		Attributes: map[string]resourceschema.Attribute{
+			names.AttrTags: resourceschema.MapAttribute{
+				ElementType: fwtypes.StringType,
+				Optional:    true,
+			},
+			names.AttrTagsAll: resourceschema.MapAttribute{
+				ElementType: fwtypes.StringType,
+				Computed:    true,
+			},
+		},
+	}
+	mapType := tftypes.Map{ElementType: tftypes.String}
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			names.AttrTags:    mapType,
+			names.AttrTagsAll: mapType,
+		},
+	}
+	object := func(tags tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			names.AttrTags:    tags,
+			names.AttrTagsAll: tftypes.NewValue(mapType, tftypes.UnknownValue),
+		})
+	}
+	tags := func(m map[string]string) tftypes.Value {
+		v := make(map[string]tftypes.Value, len(m))
+		for k, s := range m {
+			v[k] = tftypes.NewValue(tftypes.String, s)
+		}
+		return tftypes.NewValue(mapType, v)
+	}
+
+	meta := &conns.AWSClient{
+		DefaultTagsConfig: &tftags.DefaultConfig{
+			Tags: tftags.New(context.Background(), map[string]string{
+				"Owner": "team",
+			}),
+			ExcludeResourceTypes: []string{"aws_s3_*"},
+		},
+		RequiredTagsConfig: &tftags.RequiredConfig{
+			Tags: []tftags.RequiredTag{
+				{
+					Key: "Owner",
+				},
+				{
+					Key:        "CostCenter",
+					ValueRegex: regexache.MustCompile(`^cc-[0-9]{4}$`),
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		typeName      string
+		plan          tftypes.Value
+		expectedError bool
+	}{
+		"valid": {
+			typeName: "aws_vpc",
+			plan:     object(tags(map[string]string{"CostCenter": "cc-1234"})),
+		},
+		"invalid value": {
+			typeName:      "aws_vpc",
+			plan:          object(tags(map[string]string{"CostCenter": "1234"})),
+			expectedError: true,
+		},
+		"missing": {
+			typeName:      "aws_vpc",
+			plan:          object(tftypes.NewValue(mapType, nil)),
+			expectedError: true,
+		},
+		"default tag excluded": {
+			typeName:      "aws_s3_bucket",
+			plan:          object(tags(map[string]string{"CostCenter": "cc-1234"})),
+			expectedError: true,
+		},
+		"unknown": {
+			typeName: "aws_vpc",
+			plan:     object(tftypes.NewValue(mapType, tftypes.UnknownValue)),
+		},
+		"destroy": {
+			typeName: "aws_vpc",
+			plan:     tftypes.NewValue(objectType, nil),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := conns.NewResourceContext(context.Background(), "ec2", "Test", testCase.typeName)
+			ctx = tftags.NewContext(ctx, meta.ResourceDefaultTagsConfig(ctx), meta.IgnoreTagsConfig)
+
+			request := resource.ModifyPlanRequest{
+				Plan: tfsdk.Plan{Schema: s, Raw: testCase.plan},
+			}
+			response := resource.ModifyPlanResponse{
+				Plan: request.Plan,
+			}
+
+			interceptor := tagsResourceInterceptor{tags: &types.ServicePackageResourceTags{}}
+			var diags diag.Diagnostics
+			diags = interceptor.modifyPlan(ctx, request, &response, meta, diags)
+
+			if got, want := diags.HasError(), testCase.expectedError; got != want {
+				t.Errorf("HasError = %t, want %t: %v", got, want, diags)
+			}
+		})
+	}
+}
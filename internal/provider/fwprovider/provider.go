@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
@@ -53,10 +54,32 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The access key for API operations. You can retrieve this\nfrom the 'Security & Credentials' section of the AWS console.",
 			},
+			"adaptive_rate_limiting": schema.BoolAttribute{
+				Optional: true,
+				Description: "Adaptively limit the client-side AWS API request rate for each service and Region when requests are throttled. " +
+					"The maximum rate is set by `adaptive_rate_limiting_max_rate`.",
+			},
+			"adaptive_rate_limiting_max_rate": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: "The maximum AWS API request rate, in requests per second, for each service and Region when adaptive rate limiting is enabled. Defaults to `20`.",
+			},
 			"allowed_account_ids": schema.SetAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"allowed_regions": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "List of allowed AWS Regions. Conflicts with `forbidden_regions`.",
+			},
+			"api_call_log_path": schema.StringAttribute{
+				Optional: true,
+				Description: "Path to a file to which one JSON line is appended for each AWS API request. " +
+					"Can also be configured using the `TF_AWS_API_CALL_LOG_PATH` environment variable.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -69,10 +92,23 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Protocol to use with EC2 metadata service endpoint.Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
+			"endpoint_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "Endpoint URL to use for all AWS services that do not have an endpoint configured in the `endpoints` block.",
+			},
+			"endpoint_url_template": schema.StringAttribute{
+				Optional:    true,
+				Description: "Endpoint URL template to use for all AWS services that do not have an endpoint configured in the `endpoints` block. The `{service}` and `{region}` placeholders are replaced with the service name (using the same names as the `endpoints` block) and AWS Region.",
+			},
 			"forbidden_account_ids": schema.SetAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"forbidden_regions": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "List of forbidden AWS Regions. Conflicts with `allowed_regions`.",
+			},
 			"http_proxy": schema.StringAttribute{
 				Optional:    true,
 				Description: "URL of a proxy to use for HTTP requests when accessing the AWS API. Can also be set using the `HTTP_PROXY` or `http_proxy` environment variables.",
@@ -85,6 +121,12 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, default value is `false`",
 			},
+			"lookup_cache_ttl": schema.StringAttribute{
+				CustomType: fwtypes.DurationType,
+				Optional:   true,
+				Description: "Time-to-live, e.g. `10m`, of cached AWS API lookup results for data sources that read data that doesn't change during a Terraform run. " +
+					"If not set, lookup results are not cached.",
+			},
 			"max_retries": schema.Int64Attribute{
 				Optional:    true,
 				Description: "The maximum number of times an AWS API request is\nbeing executed. If the API request still fails, an error is\nthrown.",
@@ -97,6 +139,11 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The profile for API operations. If not set, the default profile\ncreated with `aws configure` will be used.",
 			},
+			"read_only": schema.BoolAttribute{
+				Optional: true,
+				Description: "Only allow read-style AWS API operations (Describe*, Get*, Head* and List*). " +
+					"Any other operation fails with an error naming the resource and operation.",
+			},
 			"region": schema.StringAttribute{
 				Optional:    true,
 				Description: "The region where AWS operations will take place. Examples\nare us-east-1, us-west-2, etc.", // lintignore:AWSAT003
@@ -168,9 +215,7 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 		},
 		Blocks: map[string]schema.Block{
 			"assume_role": schema.ListNestedBlock{
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
+				Description: "IAM Roles to assume, in order. Each IAM Role is assumed using the credentials of the previous one.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"duration": schema.StringAttribute{
@@ -260,6 +305,16 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
+						"exclude_resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource type name patterns, e.g. `aws_s3_*`, to which default tags are not applied.",
+						},
+						"include_resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource type name patterns, e.g. `aws_s3_*`, to which default tags are applied. If omitted, default tags are applied to all resource types.",
+						},
 						"tags": schema.MapAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -277,6 +332,16 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Description: "Configuration block with settings to ignore resource tags across all resources.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
+						"key_globs": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tag key glob patterns, e.g. `team/*/owner`, to ignore across all resources.",
+						},
+						"key_patterns": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tag key regular expressions (RE2 syntax), e.g. `-managed-by$`, to ignore across all resources.",
+						},
 						"key_prefixes": schema.SetAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -292,6 +357,72 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"read_only_exceptions": schema.SetNestedBlock{
+				Description: "Configuration blocks with per-service exceptions to read-only mode.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"operations": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "AWS API operation names to allow, e.g. `AssumeRole`. If omitted, all of the service's operations are allowed.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "Service, using the same names as the `endpoints` block, e.g. `sts`.",
+						},
+					},
+				},
+			},
+			"required_tags": schema.SetNestedBlock{
+				Description: "Configuration blocks with resource tags that must be present on all resources.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Required:    true,
+							Description: "Resource tag key.",
+						},
+						"value_regex": schema.StringAttribute{
+							CustomType:  fwtypes.RegexpType,
+							Optional:    true,
+							Description: "Regular expression that the resource tag value must match.",
+						},
+					},
+				},
+			},
+			"retry": schema.SetNestedBlock{
+				Description: "Configuration blocks with per-service retry policy overrides.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"error_codes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Additional AWS API error codes to retry, e.g. `InvalidParameterValueException`.",
+						},
+						"error_message_substrings": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Retry AWS API errors whose message contains any of these substrings.",
+						},
+						"max_attempts": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Maximum number of attempts, including the initial attempt, for the service's AWS API operations.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"max_backoff": schema.StringAttribute{
+							CustomType:  fwtypes.DurationType,
+							Optional:    true,
+							Description: "Maximum backoff delay between attempts, e.g. `30s`. Valid time units are ns, us (or µs), ms, s, h, or m.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "Service, using the same names as the `endpoints` block, e.g. `lambda`.",
+						},
+					},
+				},
+			},
+			"service_concurrency": serviceConcurrencyBlock(),
 		},
 	}
 }
@@ -337,7 +468,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
 					ctx = meta.RegisterLogger(ctx)
@@ -347,6 +478,22 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			}
 			interceptors := dataSourceInterceptors{}
 
+			// Per-resource Region override must be set before any other interceptor makes AWS API calls.
+			isRegionOverrideEnabled := v.Region != nil && v.Region.IsOverrideEnabled
+			if isRegionOverrideEnabled {
+				// The data source has opted in to per-resource Region override.
+				// Ensure that the schema look OK.
+				schemaResponse := datasource.SchemaResponse{}
+				inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; ok {
+					errs = append(errs, fmt.Errorf("`%s` attribute cannot be defined in schema: %s", names.AttrRegion, typeName))
+					continue
+				}
+
+				interceptors = append(interceptors, regionDataSourceInterceptor{})
+			}
+
 			if v.Tags != nil {
 				// The data source has opted in to transparent tagging.
 				// Ensure that the schema look OK.
@@ -366,8 +513,11 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 				interceptors = append(interceptors, tagsDataSourceInterceptor{tags: v.Tags})
 			}
 
+			// Service concurrency must be limited last so that a slot is only acquired if all other Before interceptors succeed.
+			interceptors = append(interceptors, serviceConcurrencyDataSourceInterceptor{})
+
 			dataSources = append(dataSources, func() datasource.DataSource {
-				return newWrappedDataSource(bootstrapContext, inner, interceptors)
+				return newWrappedDataSource(bootstrapContext, inner, interceptors, isRegionOverrideEnabled)
 			})
 		}
 	}
@@ -408,9 +558,9 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.ResourceDefaultTagsConfig(ctx), meta.IgnoreTagsConfig)
 					ctx = meta.RegisterLogger(ctx)
 					ctx = flex.RegisterLogger(ctx)
 				}
@@ -419,6 +569,22 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			}
 			interceptors := resourceInterceptors{}
 
+			// Per-resource Region override must be set before any other interceptor makes AWS API calls.
+			isRegionOverrideEnabled := v.Region != nil && v.Region.IsOverrideEnabled
+			if isRegionOverrideEnabled {
+				// The resource has opted in to per-resource Region override.
+				// Ensure that the schema look OK.
+				schemaResponse := resource.SchemaResponse{}
+				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; ok {
+					errs = append(errs, fmt.Errorf("`%s` attribute cannot be defined in schema: %s", names.AttrRegion, typeName))
+					continue
+				}
+
+				interceptors = append(interceptors, regionResourceInterceptor{})
+			}
+
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
 				// Ensure that the schema look OK.
@@ -447,8 +613,11 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				interceptors = append(interceptors, tagsResourceInterceptor{tags: v.Tags})
 			}
 
+			// Service concurrency must be limited last so that a slot is only acquired if all other Before interceptors succeed.
+			interceptors = append(interceptors, serviceConcurrencyResourceInterceptor{})
+
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				return newWrappedResource(bootstrapContext, inner, interceptors, isRegionOverrideEnabled)
 			})
 		}
 	}
@@ -470,11 +639,45 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
+		tffunction.NewARNMatchesFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCIDRSubnetPlanFunction,
+		tffunction.NewCloudInitMultipartFunction,
+		tffunction.NewDurationParseFunction,
+		tffunction.NewIAMPolicyMergeFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
+		tffunction.NewS3URIBuildFunction,
+		tffunction.NewS3URIParseFunction,
+		tffunction.NewScheduleNextRunsFunction,
+		tffunction.NewTagsEffectiveFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 	}
 }
 
+func serviceConcurrencyBlock() schema.ListNestedBlock {
+	serviceConcurrencyAttributes := make(map[string]schema.Attribute)
+
+	for _, serviceKey := range names.Aliases() {
+		serviceConcurrencyAttributes[serviceKey] = schema.Int64Attribute{
+			Optional:    true,
+			Description: "Maximum number of in-flight create, read, update and delete operations for the service's resources and data sources",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		}
+	}
+
+	return schema.ListNestedBlock{
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		Description: "Configuration block with per-service limits on the number of in-flight resource and data source operations.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: serviceConcurrencyAttributes,
+		},
+	}
+}
+
 func endpointsBlock() schema.SetNestedBlock {
 	endpointsAttributes := make(map[string]schema.Attribute)
 
@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func dataSourceRegionAttribute() datasourceschema.StringAttribute {
+	return datasourceschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The AWS Region to read the data source from. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+func resourceRegionAttribute() resourceschema.StringAttribute {
+	return resourceschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The AWS Region where the resource is managed. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// setOverrideRegion sets the per-resource Region override in Context.
+// An error diagnostic is returned if the Region is not allowed by the provider configuration.
+func setOverrideRegion(ctx context.Context, meta *conns.AWSClient, region string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok || meta == nil {
+		return diags
+	}
+
+	// Existing resources' state may predate per-resource Region override.
+	// An empty value means the provider's configured Region.
+	if region == "" || region == meta.Region {
+		inContext.OverrideRegion = ""
+	} else {
+		if err := meta.VerifyRegionAllowed(ctx, region); err != nil {
+			diags.AddAttributeError(path.Root(names.AttrRegion), "Invalid Region", err.Error())
+			return diags
+		}
+		inContext.OverrideRegion = region
+	}
+
+	return diags
+}
+
+// regionDataSourceInterceptor implements per-resource Region override for data sources.
+type regionDataSourceInterceptor struct{}
+
+func (r regionDataSourceInterceptor) read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		var region fwtypes.String
+		diags.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		diags.Append(setOverrideRegion(ctx, meta, region.ValueString())...)
+	case After:
+		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), meta.EffectiveRegion(ctx))...)
+	}
+
+	return ctx, diags
+}
+
+// regionResourceInterceptor implements per-resource Region override for resources.
+type regionResourceInterceptor struct{}
+
+func (r regionResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		var region fwtypes.String
+		diags.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		diags.Append(setOverrideRegion(ctx, meta, region.ValueString())...)
+	case After:
+		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), meta.EffectiveRegion(ctx))...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		var region fwtypes.String
+		diags.Append(request.State.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		diags.Append(setOverrideRegion(ctx, meta, region.ValueString())...)
+	case After:
+		// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+		if response.State.Raw.IsNull() {
+			return ctx, diags
+		}
+
+		// Also upgrades existing resources' state that predates per-resource Region override.
+		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), meta.EffectiveRegion(ctx))...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		var region fwtypes.String
+		diags.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		diags.Append(setOverrideRegion(ctx, meta, region.ValueString())...)
+	case After:
+		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), meta.EffectiveRegion(ctx))...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		var region fwtypes.String
+		diags.Append(request.State.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		diags.Append(setOverrideRegion(ctx, meta, region.ValueString())...)
+	}
+
+	return ctx, diags
+}
+
+// planRegion plans the provider's configured Region if `region` is not configured.
+// Changing a resource's Region replaces the resource.
+func planRegion(ctx context.Context, meta *conns.AWSClient, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() || meta == nil {
+		return
+	}
+
+	var configRegion fwtypes.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrRegion), &configRegion)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var stateRegion fwtypes.String
+	if !request.State.Raw.IsNull() {
+		response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrRegion), &stateRegion)...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	region := configRegion
+	if configRegion.IsNull() {
+		region = fwtypes.StringValue(meta.Region)
+	} else if !configRegion.IsUnknown() {
+		if err := meta.VerifyRegionAllowed(ctx, configRegion.ValueString()); err != nil {
+			response.Diagnostics.AddAttributeError(path.Root(names.AttrRegion), "Invalid Region", err.Error())
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrRegion), region)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Existing resources' state may predate per-resource Region override.
+	if !stateRegion.IsNull() && !region.Equal(stateRegion) {
+		response.RequiresReplace = append(response.RequiresReplace, path.Root(names.AttrRegion))
+	}
+}
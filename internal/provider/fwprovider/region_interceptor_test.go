@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestPlanRegion(t *testing.T) {
+	t.Parallel()
+
+	const (
+		providerRegion = "us-west-2" //lintignore:AWSAT003
+		otherRegion    = "eu-west-1" //lintignore:AWSAT003
+	)
+
+	s := resourceschema.Schema{
+		Attributes: map[string]resourceschema.Attribute{
+			names.AttrName: resourceschema.StringAttribute{
+				Required: true,
+			},
+			names.AttrRegion: resourceRegionAttribute(),
+		},
+	}
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			names.AttrName:   tftypes.String,
+			names.AttrRegion: tftypes.String,
+		},
+	}
+	object := func(region any) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			names.AttrName:   tftypes.NewValue(tftypes.String, "test"),
+			names.AttrRegion: tftypes.NewValue(tftypes.String, region),
+		})
+	}
+	null := tftypes.NewValue(objectType, nil)
+
+	testCases := map[string]struct {
+		config          tftypes.Value
+		state           tftypes.Value
+		expectedRegion  fwtypes.String
+		expectedReplace bool
+	}{
+		"create, not configured": {
+			config:         object(nil),
+			state:          null,
+			expectedRegion: fwtypes.StringValue(providerRegion),
+		},
+		"create, configured": {
+			config:         object(otherRegion),
+			state:          null,
+			expectedRegion: fwtypes.StringValue(otherRegion),
+		},
+		"create, configured unknown": {
+			config:         object(tftypes.UnknownValue),
+			state:          null,
+			expectedRegion: fwtypes.StringUnknown(),
+		},
+		"update, not configured, provider Region unchanged": {
+			config:         object(nil),
+			state:          object(providerRegion),
+			expectedRegion: fwtypes.StringValue(providerRegion),
+		},
+		"update, not configured, provider Region changed": {
+			config:          object(nil),
+			state:           object(otherRegion),
+			expectedRegion:  fwtypes.StringValue(providerRegion),
+			expectedReplace: true,
+		},
+		"update, configured, Region changed": {
+			config:          object(otherRegion),
+			state:           object(providerRegion),
+			expectedRegion:  fwtypes.StringValue(otherRegion),
+			expectedReplace: true,
+		},
+		"update, not configured, state predates Region override": {
+			config:         object(nil),
+			state:          object(nil),
+			expectedRegion: fwtypes.StringValue(providerRegion),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			meta := &conns.AWSClient{Region: providerRegion}
+
+			request := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: s, Raw: testCase.config},
+				Plan:   tfsdk.Plan{Schema: s, Raw: object(tftypes.UnknownValue)},
+				State:  tfsdk.State{Schema: s, Raw: testCase.state},
+			}
+			response := resource.ModifyPlanResponse{
+				Plan: request.Plan,
+			}
+
+			planRegion(ctx, meta, request, &response)
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", response.Diagnostics)
+			}
+
+			var region fwtypes.String
+			response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", response.Diagnostics)
+			}
+
+			if got, want := region, testCase.expectedRegion; !got.Equal(want) {
+				t.Errorf("%s = %s, want %s", names.AttrRegion, got, want)
+			}
+
+			var replace bool
+			for _, v := range response.RequiresReplace {
+				if v.Equal(path.Root(names.AttrRegion)) {
+					replace = true
+				}
+			}
+			if got, want := replace, testCase.expectedReplace; got != want {
+				t.Errorf("RequiresReplace = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestPlanRegion_destroy(t *testing.T) {
+	t.Parallel()
+
+	s := resourceschema.Schema{
+		Attributes: map[string]resourceschema.Attribute{
+			names.AttrRegion: resourceRegionAttribute(),
+		},
+	}
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			names.AttrRegion: tftypes.String,
+		},
+	}
+
+	request := resource.ModifyPlanRequest{
+		Plan: tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	response := resource.ModifyPlanResponse{
+		Plan: request.Plan,
+	}
+
+	planRegion(context.Background(), &conns.AWSClient{Region: "us-west-2"}, request, &response) //lintignore:AWSAT003
+
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", response.Diagnostics)
+	}
+	if !response.Plan.Raw.IsNull() {
+		t.Errorf("expected null plan, got %s", response.Plan.Raw)
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -79,6 +80,21 @@ const (
 	AllOps = Create | Read | Update | Delete // Interceptor is invoked for all calls
 )
 
+func (w why) String() string {
+	switch w {
+	case Create:
+		return "Create"
+	case Read:
+		return "Read"
+	case Update:
+		return "Update"
+	case Delete:
+		return "Delete"
+	default:
+		return "Unknown"
+	}
+}
+
 type interceptorItems []interceptorItem
 
 // why returns a slice of interceptors that run for the specified CRUD operation.
@@ -93,6 +109,10 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 		var diags diag.Diagnostics
 		ctx = bootstrapContext(ctx, meta)
+		ctx, span := startSpan(ctx, why)
+		defer func() {
+			endSpan(span, diags)
+		}()
 		// Before interceptors are run first to last.
 		forward := interceptors.why(why)
 
@@ -134,6 +154,35 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 	}
 }
 
+// startSpan starts a tracing span for a CRUD handler invocation.
+func startSpan(ctx context.Context, why why) (context.Context, *tracing.Span) {
+	var typeName string
+	attributes := []tracing.Attribute{
+		tracing.String("tf.operation", why.String()),
+	}
+	if v, ok := conns.FromContext(ctx); ok {
+		typeName = v.TypeName
+		attributes = append(attributes,
+			tracing.String("tf.resource_type", v.TypeName),
+			tracing.String("tf.service_package", v.ServicePackageName),
+			tracing.Bool("tf.data_source", v.IsDataSource),
+		)
+	}
+
+	return tracing.Start(ctx, typeName+" "+why.String(), tracing.SpanKindInternal, attributes...)
+}
+
+// endSpan ends a CRUD handler invocation's tracing span.
+func endSpan(span *tracing.Span, diags diag.Diagnostics) {
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			span.SetError(d.Summary)
+			break
+		}
+	}
+	span.End()
+}
+
 // contextFunc augments Context.
 type contextFunc func(context.Context, any) context.Context
 
@@ -358,8 +407,19 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 				}
 			}
 
+			apiTags := tagsInContext.TagsOut.UnwrapOrDefault().IgnoreSystem(inContext.ServicePackageName)
+
+			// Report any tags changed outside of Terraform since they were last recorded in state.
+			if why == Read && tagsInContext.TagsOut.IsSome() {
+				if expected, ok := tagsAllInState(ctx, d); ok {
+					if drift := apiTags.Drift(expected, tagsInContext.DefaultConfig, tagsInContext.IgnoreConfig, d); len(drift) > 0 {
+						diags = append(diags, tagsDriftDiag(drift, serviceName, resourceName, d.Id()))
+					}
+				}
+			}
+
 			// Remove any provider configured ignore_tags and system tags from those returned from the service API.
-			tags := tagsInContext.TagsOut.UnwrapOrDefault().IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig)
+			tags := apiTags.IgnoreConfig(tagsInContext.IgnoreConfig)
 
 			// The resource's configured tags can now include duplicate tags that have been configured on the provider.
 			if err := d.Set(names.AttrTags, tags.ResolveDuplicates(ctx, tagsInContext.DefaultConfig, tagsInContext.IgnoreConfig, d).Map()); err != nil {
@@ -487,8 +547,19 @@ func (r tagsDataSourceInterceptor) run(ctx context.Context, d schemaResourceData
 				}
 			}
 
+			apiTags := tagsInContext.TagsOut.UnwrapOrDefault().IgnoreSystem(inContext.ServicePackageName)
+
+			// Report any tags changed outside of Terraform since they were last recorded in state.
+			if why == Read && tagsInContext.TagsOut.IsSome() {
+				if expected, ok := tagsAllInState(ctx, d); ok {
+					if drift := apiTags.Drift(expected, tagsInContext.DefaultConfig, tagsInContext.IgnoreConfig, d); len(drift) > 0 {
+						diags = append(diags, tagsDriftDiag(drift, serviceName, resourceName, d.Id()))
+					}
+				}
+			}
+
 			// Remove any provider configured ignore_tags and system tags from those returned from the service API.
-			tags := tagsInContext.TagsOut.UnwrapOrDefault().IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig)
+			tags := apiTags.IgnoreConfig(tagsInContext.IgnoreConfig)
 			if err := d.Set(names.AttrTags, tags.Map()); err != nil {
 				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrTags, err)
 			}
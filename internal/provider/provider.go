@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -43,12 +44,37 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The access key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"adaptive_rate_limiting": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Adaptively limit the client-side AWS API request rate for each service and Region when requests are throttled. " +
+					"The maximum rate is set by `adaptive_rate_limiting_max_rate`.",
+			},
+			"adaptive_rate_limiting_max_rate": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The maximum AWS API request rate, in requests per second, for each service and Region when adaptive rate limiting is enabled. Defaults to `20`.",
+			},
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
 				Optional:      true,
 				ConflictsWith: []string{"forbidden_account_ids"},
 			},
+			"allowed_regions": {
+				Type:          schema.TypeSet,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Optional:      true,
+				ConflictsWith: []string{"forbidden_regions"},
+				Description:   "List of allowed AWS Regions. Conflicts with `forbidden_regions`.",
+			},
+			"api_call_log_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Path to a file to which one JSON line is appended for each AWS API request. " +
+					"Can also be configured using the `TF_AWS_API_CALL_LOG_PATH` environment variable.",
+			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
 			"custom_ca_bundle": {
@@ -65,6 +91,24 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"exclude_resource_types": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidGlobPattern,
+							},
+							Description: "Resource type name patterns, e.g. `aws_s3_*`, to which default tags are not applied.",
+						},
+						"include_resource_types": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidGlobPattern,
+							},
+							Description: "Resource type name patterns, e.g. `aws_s3_*`, to which default tags are applied. If omitted, default tags are applied to all resource types.",
+						},
 						"tags": {
 							Type:     schema.TypeMap,
 							Optional: true,
@@ -87,6 +131,22 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Protocol to use with EC2 metadata service endpoint." +
 					"Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
+			"endpoint_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.IsURLWithHTTPorHTTPS,
+				ConflictsWith: []string{"endpoint_url_template"},
+				Description: "Endpoint URL to use for all AWS services that do not have an endpoint configured " +
+					"in the `endpoints` block.",
+			},
+			"endpoint_url_template": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"endpoint_url"},
+				Description: "Endpoint URL template to use for all AWS services that do not have an endpoint configured " +
+					"in the `endpoints` block. The `{service}` and `{region}` placeholders are replaced with the service name " +
+					"(using the same names as the `endpoints` block) and AWS Region.",
+			},
 			"endpoints": endpointsSchema(),
 			"forbidden_account_ids": {
 				Type:          schema.TypeSet,
@@ -94,6 +154,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:      true,
 				ConflictsWith: []string{"allowed_account_ids"},
 			},
+			"forbidden_regions": {
+				Type:          schema.TypeSet,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Optional:      true,
+				ConflictsWith: []string{"allowed_regions"},
+				Description:   "List of forbidden AWS Regions. Conflicts with `allowed_regions`.",
+			},
 			"http_proxy": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -127,6 +194,24 @@ func New(ctx context.Context) (*schema.Provider, error) {
 							Description: "Resource tag key prefixes to ignore across all resources. " +
 								"Can also be configured with the " + tftags.IgnoreTagsKeyPrefixesEnvVar + " environment variable.",
 						},
+						"key_globs": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidGlobPattern,
+							},
+							Description: "Resource tag key glob patterns, e.g. `team/*/owner`, to ignore across all resources.",
+						},
+						"key_patterns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+							Description: "Resource tag key regular expressions (RE2 syntax), e.g. `-managed-by$`, to ignore across all resources.",
+						},
 					},
 				},
 			},
@@ -136,6 +221,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, " +
 					"default value is `false`",
 			},
+			"lookup_cache_ttl": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Time-to-live, e.g. `10m`, of cached AWS API lookup results for data sources that read data that doesn't change during a Terraform run. " +
+					"If not set, lookup results are not cached.",
+				ValidateFunc: verify.ValidDuration,
+			},
 			"max_retries": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -155,12 +247,96 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"read_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Only allow read-style AWS API operations (Describe*, Get*, Head* and List*). " +
+					"Any other operation fails with an error naming the resource and operation.",
+			},
+			"read_only_exceptions": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Configuration blocks with per-service exceptions to read-only mode.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"operations": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "AWS API operation names to allow, e.g. `AssumeRole`. If omitted, all of the service's operations are allowed.",
+						},
+						"service": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Service, using the same names as the `endpoints` block, e.g. `sts`.",
+						},
+					},
+				},
+			},
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"required_tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Configuration blocks with resource tags that must be present on all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Resource tag key.",
+						},
+						"value_regex": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Regular expression that the resource tag value must match.",
+							ValidateFunc: validation.StringIsValidRegExp,
+						},
+					},
+				},
+			},
+			"retry": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Configuration blocks with per-service retry policy overrides.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"error_codes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Additional AWS API error codes to retry, e.g. `InvalidParameterValueException`.",
+						},
+						"error_message_substrings": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Retry AWS API errors whose message contains any of these substrings.",
+						},
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "Maximum number of attempts, including the initial attempt, for the service's AWS API operations.",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"max_backoff": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Maximum backoff delay between attempts, e.g. `30s`. Valid time units are ns, us (or µs), ms, s, h, or m.",
+							ValidateFunc: verify.ValidDuration,
+						},
+						"service": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Service, using the same names as the `endpoints` block, e.g. `lambda`.",
+						},
+					},
+				},
+			},
 			"retry_mode": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -188,6 +364,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_concurrency": serviceConcurrencySchema(),
 			"shared_config_files": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -291,7 +468,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
 					ctx = v.RegisterLogger(ctx)
@@ -301,6 +478,15 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			interceptors := interceptorItems{}
 
+			// Per-resource Region override must be set before any other interceptor makes AWS API calls.
+			if isRegionOverrideEnabled(v.Region) && injectRegionAttribute(r, dataSourceRegionSchema()) {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         Read,
+					interceptor: regionDataSourceInterceptor{},
+				})
+			}
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -325,6 +511,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			// Service concurrency must be limited last so that a slot is only acquired if all other Before interceptors succeed.
+			interceptors = append(interceptors, interceptorItem{
+				when:        Before | Finally,
+				why:         Read,
+				interceptor: serviceConcurrencyInterceptor{},
+			})
+
 			ds := &wrappedDataSource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
@@ -368,9 +561,9 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.ResourceDefaultTagsConfig(ctx), v.IgnoreTagsConfig)
 					ctx = v.RegisterLogger(ctx)
 				}
 
@@ -378,6 +571,27 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			interceptors := interceptorItems{}
 
+			// Per-resource Region override must be set before any other interceptor makes AWS API calls.
+			if isRegionOverrideEnabled(v.Region) && injectRegionAttribute(r, resourceRegionSchema()) {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         AllOps,
+					interceptor: regionResourceInterceptor{},
+				})
+
+				if v := r.CustomizeDiff; v != nil {
+					r.CustomizeDiff = customdiff.Sequence(setRegionInPlan, v)
+				} else {
+					r.CustomizeDiff = setRegionInPlan
+				}
+
+				if v := r.Importer; v != nil {
+					if v := v.StateContext; v != nil {
+						r.Importer.StateContext = importRegionalID(v)
+					}
+				}
+			}
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -413,6 +627,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			// Service concurrency must be limited last so that a slot is only acquired if all other Before interceptors succeed.
+			interceptors = append(interceptors, interceptorItem{
+				when:        Before | Finally,
+				why:         AllOps,
+				interceptor: serviceConcurrencyInterceptor{},
+			})
+
 			rs := &wrappedResource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
@@ -480,9 +701,13 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		AdaptiveRateLimiting:           d.Get("adaptive_rate_limiting").(bool),
+		AdaptiveRateLimitingMaxRate:    d.Get("adaptive_rate_limiting_max_rate").(int),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
+		EndpointURL:                    d.Get("endpoint_url").(string),
+		EndpointURLTemplate:            d.Get("endpoint_url_template").(string),
 		Endpoints:                      make(map[string]string),
 		Insecure:                       d.Get("insecure").(bool),
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
@@ -517,14 +742,33 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
-	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.AssumeRole = expandAssumeRole(ctx, v.([]interface{})[0].(map[string]interface{}))
-		tflog.Info(ctx, "assume_role configuration set", map[string]any{
-			"tf_aws.assume_role.role_arn":        config.AssumeRole.RoleARN,
-			"tf_aws.assume_role.session_name":    config.AssumeRole.SessionName,
-			"tf_aws.assume_role.external_id":     config.AssumeRole.ExternalID,
-			"tf_aws.assume_role.source_identity": config.AssumeRole.SourceIdentity,
-		})
+	if v, ok := d.GetOk("allowed_regions"); ok && v.(*schema.Set).Len() > 0 {
+		config.AllowedRegions = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.Get("api_call_log_path").(string); ok && v != "" {
+		config.APICallLogPath = v
+	} else {
+		config.APICallLogPath = os.Getenv(conns.APICallLogPathEnvVar)
+	}
+
+	if v, ok := d.GetOk("assume_role"); ok {
+		for i, tfMapRaw := range v.([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			assumeRole := expandAssumeRole(ctx, tfMap)
+			config.AssumeRole = append(config.AssumeRole, assumeRole)
+			tflog.Info(ctx, "assume_role configuration set", map[string]any{
+				"tf_aws.assume_role.index":           i,
+				"tf_aws.assume_role.role_arn":        assumeRole.RoleARN,
+				"tf_aws.assume_role.session_name":    assumeRole.SessionName,
+				"tf_aws.assume_role.external_id":     assumeRole.ExternalID,
+				"tf_aws.assume_role.source_identity": assumeRole.SourceIdentity,
+			})
+		}
 	}
 
 	if v, ok := d.GetOk("assume_role_with_web_identity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -553,6 +797,10 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.ForbiddenAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("forbidden_regions"); ok && v.(*schema.Set).Len() > 0 {
+		config.ForbiddenRegions = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOkExists("http_proxy"); ok {
 		if s, sok := v.(string); sok {
 			config.HTTPProxy = aws.String(s)
@@ -574,10 +822,46 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, nil)
 	}
 
+	if v, ok := d.GetOk("lookup_cache_ttl"); ok {
+		ttl, err := time.ParseDuration(v.(string))
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "lookup_cache_ttl: %s", err)
+		}
+		config.LookupCacheTTL = ttl
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("read_only"); ok {
+		config.ReadOnly = v.(bool)
+	}
+
+	if v, ok := d.GetOk("read_only_exceptions"); ok && v.(*schema.Set).Len() > 0 {
+		exceptions, err := expandReadOnlyExceptions(ctx, v.(*schema.Set).List())
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		config.ReadOnlyExceptions = exceptions
+	}
+
+	if v, ok := d.GetOk("required_tags"); ok && v.(*schema.Set).Len() > 0 {
+		config.RequiredTagsConfig = expandRequiredTags(ctx, v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("retry"); ok && v.(*schema.Set).Len() > 0 {
+		retryPolicies, err := expandRetryPolicies(ctx, v.(*schema.Set).List())
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		config.RetryPolicies = retryPolicies
+	}
+
+	if v, ok := d.GetOk("service_concurrency"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.ServiceConcurrency = expandServiceConcurrency(ctx, v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -612,9 +896,9 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "IAM Roles to assume, in order. Each IAM Role is assumed using the credentials of the previous one.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration": {
@@ -759,6 +1043,29 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func serviceConcurrencySchema() *schema.Schema {
+	serviceConcurrencyAttributes := make(map[string]*schema.Schema)
+
+	for _, serviceKey := range names.Aliases() {
+		serviceConcurrencyAttributes[serviceKey] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  "Maximum number of in-flight create, read, update and delete operations for the service's resources and data sources",
+			ValidateFunc: validation.IntAtLeast(0),
+		}
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration block with per-service limits on the number of in-flight resource and data source operations.",
+		Elem: &schema.Resource{
+			Schema: serviceConcurrencyAttributes,
+		},
+	}
+}
+
 func expandAssumeRole(_ context.Context, tfMap map[string]interface{}) *awsbase.AssumeRole {
 	if tfMap == nil {
 		return nil
@@ -861,9 +1168,18 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 	}
 
 	if len(tags) > 0 {
-		return &tftags.DefaultConfig{
+		defaultConfig := &tftags.DefaultConfig{
 			Tags: tftags.New(ctx, tags),
 		}
+
+		if v, ok := tfMap["exclude_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+			defaultConfig.ExcludeResourceTypes = flex.ExpandStringValueSet(v)
+		}
+		if v, ok := tfMap["include_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+			defaultConfig.IncludeResourceTypes = flex.ExpandStringValueSet(v)
+		}
+
+		return defaultConfig
 	}
 
 	return nil
@@ -871,6 +1187,7 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 
 func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	var keys, keyPrefixes []interface{}
+	var keyGlobs, keyPatterns []string
 
 	if tfMap != nil {
 		if v, ok := tfMap["keys"].(*schema.Set); ok {
@@ -879,6 +1196,12 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 		if v, ok := tfMap["key_prefixes"].(*schema.Set); ok {
 			keyPrefixes = v.List()
 		}
+		if v, ok := tfMap["key_globs"].(*schema.Set); ok {
+			keyGlobs = flex.ExpandStringValueSet(v)
+		}
+		if v, ok := tfMap["key_patterns"].(*schema.Set); ok {
+			keyPatterns = flex.ExpandStringValueSet(v)
+		}
 	}
 
 	if v := os.Getenv(tftags.IgnoreTagsKeysEnvVar); v != "" {
@@ -899,10 +1222,10 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 
 	// To preseve behavior prior to supporting environment variables:
 	//
-	// - Return nil when no keys or prefixes are set
+	// - Return nil when no keys, prefixes, globs or patterns are set
 	// - For a non-nil return, `keys` or `key_prefixes` should be
 	//   nil if empty (versus a zero-value `KeyValueTags` struct)
-	if len(keys) == 0 && len(keyPrefixes) == 0 {
+	if len(keys) == 0 && len(keyPrefixes) == 0 && len(keyGlobs) == 0 && len(keyPatterns) == 0 {
 		return nil
 	}
 
@@ -913,10 +1236,130 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	if len(keyPrefixes) > 0 {
 		ignoreConfig.KeyPrefixes = tftags.New(ctx, keyPrefixes)
 	}
+	if len(keyGlobs) > 0 {
+		ignoreConfig.KeyGlobs = keyGlobs
+	}
+	for _, v := range keyPatterns {
+		ignoreConfig.KeyPatterns = append(ignoreConfig.KeyPatterns, regexache.MustCompile(v))
+	}
 
 	return ignoreConfig
 }
 
+func expandReadOnlyExceptions(_ context.Context, tfList []interface{}) (map[string][]string, error) {
+	exceptions := make(map[string][]string)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		service, err := names.ProviderPackageForAlias(tfMap["service"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("read_only_exceptions: %w", err)
+		}
+
+		if _, ok := exceptions[service]; ok {
+			return nil, fmt.Errorf("read_only_exceptions: duplicate configuration for service %q", service)
+		}
+
+		var operations []string
+		if v, ok := tfMap["operations"].(*schema.Set); ok && v.Len() > 0 {
+			operations = flex.ExpandStringValueSet(v)
+		}
+		exceptions[service] = operations
+	}
+
+	return exceptions, nil
+}
+
+func expandRequiredTags(_ context.Context, tfList []interface{}) *tftags.RequiredConfig {
+	requiredConfig := &tftags.RequiredConfig{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		requiredTag := tftags.RequiredTag{
+			Key: tfMap["key"].(string),
+		}
+		if v, ok := tfMap["value_regex"].(string); ok && v != "" {
+			requiredTag.ValueRegex = regexache.MustCompile(v)
+		}
+
+		requiredConfig.Tags = append(requiredConfig.Tags, requiredTag)
+	}
+
+	return requiredConfig
+}
+
+func expandRetryPolicies(_ context.Context, tfList []interface{}) (map[string]*conns.RetryPolicy, error) {
+	retryPolicies := make(map[string]*conns.RetryPolicy)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		service, err := names.ProviderPackageForAlias(tfMap["service"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("retry: %w", err)
+		}
+
+		if _, ok := retryPolicies[service]; ok {
+			return nil, fmt.Errorf("retry: duplicate configuration for service %q", service)
+		}
+
+		retryPolicy := &conns.RetryPolicy{}
+
+		if v, ok := tfMap["error_codes"].(*schema.Set); ok && v.Len() > 0 {
+			retryPolicy.ErrorCodes = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["error_message_substrings"].(*schema.Set); ok && v.Len() > 0 {
+			retryPolicy.ErrorMessages = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["max_attempts"].(int); ok && v > 0 {
+			retryPolicy.MaxAttempts = v
+		}
+
+		if v, ok := tfMap["max_backoff"].(string); ok && v != "" {
+			maxBackoff, err := time.ParseDuration(v)
+			if err != nil {
+				return nil, fmt.Errorf("retry: max_backoff: %w", err)
+			}
+			retryPolicy.MaxBackoff = maxBackoff
+		}
+
+		retryPolicies[service] = retryPolicy
+	}
+
+	return retryPolicies, nil
+}
+
+func expandServiceConcurrency(_ context.Context, tfMap map[string]interface{}) map[string]int {
+	serviceConcurrency := make(map[string]int)
+
+	for _, endpoint := range names.Endpoints() {
+		pkg := endpoint.ProviderPackage
+
+		// The provider package name takes precedence over any alias.
+		for _, key := range append([]string{pkg}, endpoint.Aliases...) {
+			if v, ok := tfMap[key].(int); ok && v > 0 {
+				serviceConcurrency[pkg] = v
+				break
+			}
+		}
+	}
+
+	return serviceConcurrency
+}
+
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
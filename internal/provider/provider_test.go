@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -297,6 +298,68 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandServiceConcurrency(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testcases := map[string]struct {
+		tfMap    map[string]interface{}
+		expected map[string]int
+	}{
+		"empty": {
+			tfMap:    map[string]interface{}{},
+			expected: map[string]int{},
+		},
+		"zero": {
+			tfMap: map[string]interface{}{
+				"route53": 0,
+			},
+			expected: map[string]int{},
+		},
+		"package names": {
+			tfMap: map[string]interface{}{
+				"iam":           4,
+				"organizations": 1,
+			},
+			expected: map[string]int{
+				"iam":           4,
+				"organizations": 1,
+			},
+		},
+		"alias": {
+			tfMap: map[string]interface{}{
+				"cloudwatchlogs": 2,
+			},
+			expected: map[string]int{
+				"logs": 2,
+			},
+		},
+		"package name and alias": {
+			tfMap: map[string]interface{}{
+				"cloudwatchlogs": 2,
+				"logs":           3,
+			},
+			expected: map[string]int{
+				"logs": 3,
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := expandServiceConcurrency(ctx, testcase.tfMap)
+
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := map[string]struct {
@@ -412,6 +475,32 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandIgnoreTagsKeyGlobsAndPatterns(t *testing.T) { //nolint:paralleltest
+	ctx := context.Background()
+
+	oldEnv := stashEnv()
+	defer popEnv(oldEnv)
+
+	got := expandIgnoreTags(ctx, map[string]interface{}{
+		"key_globs":    schema.NewSet(schema.HashString, []interface{}{"team/*/owner"}),
+		"key_patterns": schema.NewSet(schema.HashString, []interface{}{"^aws:cloudformation:", "-managed-by$"}),
+	})
+
+	if got == nil {
+		t.Fatal("expected ignore tags config")
+	}
+	if diff := cmp.Diff(got.KeyGlobs, []string{"team/*/owner"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+	var keyPatterns []string
+	for _, v := range got.KeyPatterns {
+		keyPatterns = append(keyPatterns, v.String())
+	}
+	if diff := cmp.Diff(keyPatterns, []string{"-managed-by$", "^aws:cloudformation:"}, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
 func stashEnv() []string {
 	env := os.Environ()
 	os.Clearenv()
@@ -426,3 +515,156 @@ func popEnv(env []string) {
 		os.Setenv(k, v)
 	}
 }
+
+func TestExpandReadOnlyExceptions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testcases := map[string]struct {
+		tfList      []interface{}
+		expected    map[string][]string
+		expectedErr bool
+	}{
+		"empty": {
+			tfList:   []interface{}{},
+			expected: map[string][]string{},
+		},
+		"all operations": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"service":    "athena",
+					"operations": schema.NewSet(schema.HashString, []interface{}{}),
+				},
+			},
+			expected: map[string][]string{
+				"athena": nil,
+			},
+		},
+		"operations": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"service":    "cloudwatchlogs",
+					"operations": schema.NewSet(schema.HashString, []interface{}{"StartQuery"}),
+				},
+			},
+			expected: map[string][]string{
+				"logs": {"StartQuery"},
+			},
+		},
+		"duplicate service": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"service":    "lambda",
+					"operations": schema.NewSet(schema.HashString, []interface{}{}),
+				},
+				map[string]interface{}{
+					"service":    "lambda",
+					"operations": schema.NewSet(schema.HashString, []interface{}{"Invoke"}),
+				},
+			},
+			expectedErr: true,
+		},
+		"duplicate service alias": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"service": "logs",
+				},
+				map[string]interface{}{
+					"service": "cloudwatchlogs",
+				},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := expandReadOnlyExceptions(ctx, testcase.tfList)
+
+			if testcase.expectedErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandDefaultTagsResourceTypes(t *testing.T) { //nolint:paralleltest
+	ctx := context.Background()
+
+	oldEnv := stashEnv()
+	defer popEnv(oldEnv)
+
+	got := expandDefaultTags(ctx, map[string]interface{}{
+		"exclude_resource_types": schema.NewSet(schema.HashString, []interface{}{"aws_s3_object"}),
+		"include_resource_types": schema.NewSet(schema.HashString, []interface{}{"aws_s3_*", "aws_vpc"}),
+		"tags": map[string]interface{}{
+			"Owner": "team",
+		},
+	})
+
+	if got == nil {
+		t.Fatal("expected default tags config")
+	}
+	if diff := cmp.Diff(got.ExcludeResourceTypes, []string{"aws_s3_object"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+	if diff := cmp.Diff(got.IncludeResourceTypes, []string{"aws_s3_*", "aws_vpc"}, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestExpandRequiredTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	got := expandRequiredTags(ctx, []interface{}{
+		map[string]interface{}{
+			"key":         "Owner",
+			"value_regex": "",
+		},
+		map[string]interface{}{
+			"key":         "CostCenter",
+			"value_regex": `^cc-[0-9]{4}$`,
+		},
+	})
+
+	if got, want := len(got.Tags), 2; got != want {
+		t.Fatalf("required tags: got %d, want %d", got, want)
+	}
+
+	for _, v := range got.Tags {
+		switch v.Key {
+		case "Owner":
+			if v.ValueRegex != nil {
+				t.Errorf("unexpected value regex for %s: %s", v.Key, v.ValueRegex)
+			}
+		case "CostCenter":
+			if v.ValueRegex == nil || v.ValueRegex.String() != `^cc-[0-9]{4}$` {
+				t.Errorf("unexpected value regex for %s: %v", v.Key, v.ValueRegex)
+			}
+		default:
+			t.Errorf("unexpected required tag: %s", v.Key)
+		}
+	}
+
+	if err := got.Validate(tftags.New(ctx, map[string]string{"Owner": "team", "CostCenter": "cc-1234"})); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	if err := got.Validate(tftags.New(ctx, map[string]string{"CostCenter": "1234"})); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// isRegionOverrideEnabled returns whether a Plugin SDK resource or data source supports per-resource Region override.
+// Resources and data sources must opt in once they have been audited to use the effective Region, e.g. when constructing ARNs.
+func isRegionOverrideEnabled(v *types.ServicePackageResourceRegion) bool {
+	return v != nil && v.IsOverrideEnabled
+}
+
+func dataSourceRegionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		Description:  "The AWS Region to read the data source from. Defaults to the Region set in the provider configuration.",
+		ValidateFunc: verify.ValidRegionName,
+	}
+}
+
+func resourceRegionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		Description:  "The AWS Region where the resource is managed. Defaults to the Region set in the provider configuration.",
+		ValidateFunc: verify.ValidRegionName,
+	}
+}
+
+// injectRegionAttribute adds a top-level `region` attribute to the resource's schema.
+// Returns false if the schema already defines a `region` attribute.
+func injectRegionAttribute(r *schema.Resource, s *schema.Schema) bool {
+	if _, ok := r.SchemaMap()[names.AttrRegion]; ok {
+		return false
+	}
+
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			m := f()
+			m[names.AttrRegion] = s
+			return m
+		}
+	} else {
+		r.Schema[names.AttrRegion] = s
+	}
+
+	return true
+}
+
+// setOverrideRegion sets the per-resource Region override in Context.
+// An error is returned if the Region is not allowed by the provider configuration.
+func setOverrideRegion(ctx context.Context, meta any, region string) error {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	// Existing resources' state may predate per-resource Region override.
+	// An empty value means the provider's configured Region.
+	if region == "" || region == meta.(*conns.AWSClient).Region {
+		inContext.OverrideRegion = ""
+	} else {
+		if err := meta.(*conns.AWSClient).VerifyRegionAllowed(ctx, region); err != nil {
+			return err
+		}
+		inContext.OverrideRegion = region
+	}
+
+	return nil
+}
+
+// regionDataSourceInterceptor implements per-resource Region override for data sources.
+type regionDataSourceInterceptor struct{}
+
+func (r regionDataSourceInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		switch why {
+		case Read:
+			if err := setOverrideRegion(ctx, meta, d.Get(names.AttrRegion).(string)); err != nil {
+				return ctx, sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	case After:
+		switch why {
+		case Read:
+			if err := d.Set(names.AttrRegion, meta.(*conns.AWSClient).EffectiveRegion(ctx)); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// regionResourceInterceptor implements per-resource Region override for resources.
+type regionResourceInterceptor struct{}
+
+func (r regionResourceInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		if err := setOverrideRegion(ctx, meta, d.Get(names.AttrRegion).(string)); err != nil {
+			return ctx, sdkdiag.AppendFromErr(diags, err)
+		}
+	case After:
+		switch why {
+		case Read:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+			if d.Id() == "" {
+				return ctx, diags
+			}
+
+			fallthrough
+		case Create, Update:
+			// Also upgrades existing resources' state that predates per-resource Region override.
+			if err := d.Set(names.AttrRegion, meta.(*conns.AWSClient).EffectiveRegion(ctx)); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// setRegionInPlan is a CustomizeDiff function that plans the provider's configured Region if `region` is not configured.
+// Changing the provider's Region therefore replaces resources that don't configure their own Region.
+func setRegionInPlan(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	// Configured, possibly with an unknown value.
+	if v := config.GetAttr(names.AttrRegion); !v.IsNull() {
+		if v.IsKnown() {
+			return meta.(*conns.AWSClient).VerifyRegionAllowed(ctx, v.AsString())
+		}
+
+		return nil
+	}
+
+	// Existing resources' state may predate per-resource Region override.
+	// The value is set on the next Read.
+	if o, _ := d.GetChange(names.AttrRegion); d.Id() != "" && o.(string) == "" {
+		return nil
+	}
+
+	if region := meta.(*conns.AWSClient).Region; d.Get(names.AttrRegion).(string) != region {
+		return d.SetNew(names.AttrRegion, region)
+	}
+
+	return nil
+}
+
+// importRegionalID wraps a resource's import handler, supporting import IDs of the form `<id>@<region>`.
+func importRegionalID(f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		if id, region, ok := verify.ParseRegionalImportID(d.Id()); ok {
+			d.SetId(id)
+			if err := d.Set(names.AttrRegion, region); err != nil {
+				return nil, err
+			}
+			if err := setOverrideRegion(ctx, meta, region); err != nil {
+				return nil, err
+			}
+		}
+
+		return f(ctx, d, meta)
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestInjectRegionAttribute(t *testing.T) {
+	t.Parallel()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+
+	if got, want := injectRegionAttribute(r, resourceRegionSchema()), true; got != want {
+		t.Errorf("injectRegionAttribute = %v, want %v", got, want)
+	}
+	if _, ok := r.SchemaMap()[names.AttrRegion]; !ok {
+		t.Errorf("no %s attribute in schema", names.AttrRegion)
+	}
+	if got, want := injectRegionAttribute(r, resourceRegionSchema()), false; got != want {
+		t.Errorf("injectRegionAttribute (already injected) = %v, want %v", got, want)
+	}
+}
+
+func TestInjectRegionAttribute_schemaFunc(t *testing.T) {
+	t.Parallel()
+
+	r := &schema.Resource{
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				names.AttrName: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			}
+		},
+	}
+
+	if got, want := injectRegionAttribute(r, dataSourceRegionSchema()), true; got != want {
+		t.Errorf("injectRegionAttribute = %v, want %v", got, want)
+	}
+	if _, ok := r.SchemaMap()[names.AttrRegion]; !ok {
+		t.Errorf("no %s attribute in schema", names.AttrRegion)
+	}
+}
+
+func TestInjectRegionAttribute_existing(t *testing.T) {
+	t.Parallel()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrRegion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+
+	if got, want := injectRegionAttribute(r, resourceRegionSchema()), false; got != want {
+		t.Errorf("injectRegionAttribute = %v, want %v", got, want)
+	}
+	if v := r.SchemaMap()[names.AttrRegion]; v.Optional {
+		t.Errorf("existing %s attribute overwritten", names.AttrRegion)
+	}
+}
+
+func TestSetRegionInPlan(t *testing.T) {
+	t.Parallel()
+
+	const (
+		providerRegion = "us-west-2" //lintignore:AWSAT003
+		otherRegion    = "eu-west-1" //lintignore:AWSAT003
+	)
+
+	testCases := map[string]struct {
+		id              string
+		stateRegion     string
+		configRegion    cty.Value
+		expectedRegion  string
+		expectedReplace bool
+	}{
+		"create, not configured": {
+			configRegion:   cty.NullVal(cty.String),
+			expectedRegion: providerRegion,
+		},
+		"create, configured": {
+			configRegion:   cty.StringVal(otherRegion),
+			expectedRegion: otherRegion,
+		},
+		"update, not configured, provider Region unchanged": {
+			id:           "id-1",
+			stateRegion:  providerRegion,
+			configRegion: cty.NullVal(cty.String),
+		},
+		"update, not configured, provider Region changed": {
+			id:              "id-1",
+			stateRegion:     otherRegion,
+			configRegion:    cty.NullVal(cty.String),
+			expectedRegion:  providerRegion,
+			expectedReplace: true,
+		},
+		"update, configured, Region changed": {
+			id:              "id-1",
+			stateRegion:     providerRegion,
+			configRegion:    cty.StringVal(otherRegion),
+			expectedRegion:  otherRegion,
+			expectedReplace: true,
+		},
+		"update, not configured, state predates Region override": {
+			id:           "id-1",
+			configRegion: cty.NullVal(cty.String),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			meta := &conns.AWSClient{Region: providerRegion}
+
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrName: {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+				CustomizeDiff: setRegionInPlan,
+			}
+			injectRegionAttribute(r, resourceRegionSchema())
+
+			raw := map[string]interface{}{
+				names.AttrName: "test",
+			}
+			rawConfig := map[string]cty.Value{
+				names.AttrID:     cty.NullVal(cty.String),
+				names.AttrName:   cty.StringVal("test"),
+				names.AttrRegion: testCase.configRegion,
+			}
+			if !testCase.configRegion.IsNull() {
+				raw[names.AttrRegion] = testCase.configRegion.AsString()
+			}
+
+			state := &terraform.InstanceState{
+				ID:        testCase.id,
+				RawConfig: cty.ObjectVal(rawConfig),
+			}
+			if testCase.id != "" {
+				state.Attributes = map[string]string{
+					names.AttrID:     testCase.id,
+					names.AttrName:   "test",
+					names.AttrRegion: testCase.stateRegion,
+				}
+			}
+
+			diff, err := r.SimpleDiff(ctx, state, terraform.NewResourceConfigRaw(raw), meta)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			v, ok := diff.Attributes[names.AttrRegion]
+			if testCase.expectedRegion == "" {
+				if ok && v.New != v.Old {
+					t.Errorf("unexpected %s diff: %q => %q", names.AttrRegion, v.Old, v.New)
+				}
+				return
+			}
+
+			if !ok {
+				t.Fatalf("no %s diff", names.AttrRegion)
+			}
+			if got, want := v.New, testCase.expectedRegion; got != want {
+				t.Errorf("%s = %q, want %q", names.AttrRegion, got, want)
+			}
+			if testCase.id == "" {
+				return
+			}
+			if got, want := v.RequiresNew, testCase.expectedReplace; got != want {
+				t.Errorf("RequiresNew = %t, want %t", got, want)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -42,18 +43,6 @@ func tagsUpdateFunc(ctx context.Context, d schemaResourceData, sp conns.ServiceP
 		return ctx, diags
 	}
 
-	configTags := make(map[string]string)
-	if config := d.GetRawConfig(); !config.IsNull() && config.IsKnown() {
-		c := config.GetAttr(names.AttrTags)
-		if !c.IsNull() {
-			for k, v := range c.AsValueMap() {
-				if !v.IsNull() {
-					configTags[k] = v.AsString()
-				}
-			}
-		}
-	}
-
 	stateTags := make(map[string]string)
 	if state := d.GetRawState(); !state.IsNull() && state.IsKnown() {
 		s := state.GetAttr(names.AttrTagsAll)
@@ -68,10 +57,7 @@ func tagsUpdateFunc(ctx context.Context, d schemaResourceData, sp conns.ServiceP
 
 	oldTags := tftags.New(ctx, stateTags)
 	// if tags_all was computed because not wholly known
-	// Merge the resource's configured tags with any provider configured default_tags.
-	newTags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, configTags))
-	// Remove system tags.
-	newTags = newTags.IgnoreSystem(inContext.ServicePackageName)
+	newTags := configuredTagsAll(ctx, d, inContext, tagsInContext)
 
 	// If the service package has a generic resource update tags methods, call it.
 	var err error
@@ -157,8 +143,18 @@ func tagsReadFunc(ctx context.Context, d schemaResourceData, sp conns.ServicePac
 		}
 	}
 
+	apiTags := tagsInContext.TagsOut.UnwrapOrDefault().IgnoreSystem(inContext.ServicePackageName)
+
+	// Report any tags that AWS changed after they were updated, e.g. tags added by an AWS service or tag policy.
+	expected := configuredTagsAll(ctx, d, inContext, tagsInContext).IgnoreConfig(tagsInContext.IgnoreConfig)
+	if tagsInContext.TagsOut.IsSome() {
+		if drift := apiTags.Drift(expected, tagsInContext.DefaultConfig, tagsInContext.IgnoreConfig, d); len(drift) > 0 {
+			diags = append(diags, tagsDriftDiag(drift, serviceName, resourceName, identifier))
+		}
+	}
+
 	// Remove any provider configured ignore_tags and system tags from those returned from the service API.
-	toAdd := tagsInContext.TagsOut.UnwrapOrDefault().IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig)
+	toAdd := apiTags.IgnoreConfig(tagsInContext.IgnoreConfig)
 
 	// The resource's configured tags can now include duplicate tags that have been configured on the provider.
 	if err := d.Set(names.AttrTags, toAdd.ResolveDuplicates(ctx, tagsInContext.DefaultConfig, tagsInContext.IgnoreConfig, d).Map()); err != nil {
@@ -172,3 +168,51 @@ func tagsReadFunc(ctx context.Context, d schemaResourceData, sp conns.ServicePac
 
 	return ctx, diags
 }
+
+// configuredTagsAll returns the resource's configured tags merged with any provider configured default_tags, excluding system tags.
+func configuredTagsAll(ctx context.Context, d schemaResourceData, inContext *conns.InContext, tagsInContext *tftags.InContext) tftags.KeyValueTags {
+	configTags := make(map[string]string)
+	if config := d.GetRawConfig(); !config.IsNull() && config.IsKnown() {
+		c := config.GetAttr(names.AttrTags)
+		if !c.IsNull() {
+			for k, v := range c.AsValueMap() {
+				if !v.IsNull() {
+					configTags[k] = v.AsString()
+				}
+			}
+		}
+	}
+
+	// Merge the resource's configured tags with any provider configured default_tags.
+	tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, configTags))
+	// Remove system tags.
+	return tags.IgnoreSystem(inContext.ServicePackageName)
+}
+
+// tagsAllInState returns the tags_all recorded in the resource's prior state.
+// Returns false if there is no prior value, e.g. on Create or Import.
+func tagsAllInState(ctx context.Context, d schemaResourceData) (tftags.KeyValueTags, bool) {
+	state := d.GetRawState()
+	if state.IsNull() || !state.IsKnown() {
+		return nil, false
+	}
+
+	s := state.GetAttr(names.AttrTagsAll)
+	if s.IsNull() || !s.IsKnown() {
+		return nil, false
+	}
+
+	tags := make(map[string]string)
+	for k, v := range s.AsValueMap() {
+		if !v.IsNull() {
+			tags[k] = v.AsString()
+		}
+	}
+
+	return tftags.New(ctx, tags), true
+}
+
+// tagsDriftDiag returns a warning diagnostic listing the tags changed outside of Terraform.
+func tagsDriftDiag(drift tftags.TagsDrift, serviceName, resourceName, identifier string) diag.Diagnostic {
+	return errs.NewWarningDiagnostic(tftags.DriftSummary, drift.Detail(fmt.Sprintf("%s %s (%s)", serviceName, resourceName, identifier)))
+}
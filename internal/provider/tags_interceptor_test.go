@@ -6,14 +6,18 @@ package provider
 import (
 	"context"
 	"errors"
+	"regexp"
 	"testing"
 
+	"github.com/YakDriver/regexache"
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type mockService struct{}
@@ -87,7 +91,7 @@ func TestTagsResourceInterceptor(t *testing.T) {
 	}
 
 	bootstrapContext := func(ctx context.Context, meta any) context.Context {
-		ctx = conns.NewResourceContext(ctx, "Test", "aws_test")
+		ctx = conns.NewResourceContext(ctx, "Test", "aws_test", "aws_test")
 		if v, ok := meta.(*conns.AWSClient); ok {
 			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
 		}
@@ -148,3 +152,174 @@ func (d *resourceData) GetChange(key string) (interface{}, interface{}) {
 func (d *resourceData) HasChange(key string) bool {
 	return false
 }
+
+type mockTaggingService struct {
+	mockService
+	tags map[string]string
+}
+
+func (t *mockTaggingService) ListTags(ctx context.Context, meta any, identifier string) error {
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		inContext.TagsOut = option.Some(tftags.New(ctx, t.tags))
+	}
+
+	return nil
+}
+
+type taggedResourceData struct {
+	resourceData
+	values map[string]any
+}
+
+func (d *taggedResourceData) GetRawConfig() cty.Value {
+	return cty.ObjectVal(map[string]cty.Value{
+		"tags": cty.MapVal(map[string]cty.Value{
+			"tag1": cty.StringVal("value1"),
+		}),
+	})
+}
+
+func (d *taggedResourceData) GetRawPlan() cty.Value {
+	return cty.NullVal(cty.DynamicPseudoType)
+}
+
+func (d *taggedResourceData) GetRawState() cty.Value { // nosemgrep:ci.aws-in-func-name
+	return cty.NullVal(cty.DynamicPseudoType)
+}
+
+func (d *taggedResourceData) Set(key string, v any) error {
+	d.values[key] = v
+	return nil
+}
+
+func TestTagsReadFuncIgnoreConfig(t *testing.T) {
+	t.Parallel()
+
+	sp := &mockTaggingService{
+		tags: map[string]string{
+			"tag1":                          "value1",
+			"aws:cloudformation:stack-name": "stack",
+			"billing-managed-by":            "automation",
+			"team/platform/owner":           "someone",
+			"team/platform/cost-center":     "cc-1234",
+		},
+	}
+	spt := &types.ServicePackageResourceTags{
+		IdentifierAttribute: "id",
+	}
+
+	testCases := map[string]struct {
+		ignoreConfig *tftags.IgnoreConfig
+		expected     map[string]string
+	}{
+		"key globs": {
+			ignoreConfig: &tftags.IgnoreConfig{
+				KeyGlobs: []string{"aws:cloudformation:*", "*-managed-by", "team/*/owner"},
+			},
+			expected: map[string]string{
+				"tag1":                      "value1",
+				"team/platform/cost-center": "cc-1234",
+			},
+		},
+		"key patterns": {
+			ignoreConfig: &tftags.IgnoreConfig{
+				KeyPatterns: []*regexp.Regexp{
+					regexache.MustCompile(`^team/`),
+					regexache.MustCompile(`-managed-by$`),
+				},
+			},
+			expected: map[string]string{
+				"tag1":                          "value1",
+				"aws:cloudformation:stack-name": "stack",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := &conns.AWSClient{
+				IgnoreTagsConfig: testCase.ignoreConfig,
+			}
+
+			ctx := conns.NewResourceContext(context.Background(), "Test", "aws_test", "aws_test")
+			ctx = tftags.NewContext(ctx, conn.DefaultTagsConfig, conn.IgnoreTagsConfig)
+			d := &taggedResourceData{values: make(map[string]any)}
+
+			_, diags := tagsReadFunc(ctx, d, sp, spt, "Test", "aws_test", conn, nil)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			for _, key := range []string{names.AttrTags, names.AttrTagsAll} {
+				if diff := cmp.Diff(d.values[key], testCase.expected); diff != "" {
+					t.Errorf("%s: unexpected diff (+wanted, -got): %s", key, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestTagsReadFuncDrift(t *testing.T) {
+	t.Parallel()
+
+	spt := &types.ServicePackageResourceTags{
+		IdentifierAttribute: "id",
+	}
+
+	testCases := map[string]struct {
+		tags          map[string]string
+		expectedDiags int
+	}{
+		"no drift": {
+			tags: map[string]string{
+				"tag1": "value1",
+			},
+		},
+		"added by AWS": {
+			tags: map[string]string{
+				"tag1":     "value1",
+				"external": "value",
+			},
+			expectedDiags: 1,
+		},
+		"changed by AWS": {
+			tags: map[string]string{
+				"tag1": "value2",
+			},
+			expectedDiags: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			sp := &mockTaggingService{
+				tags: testCase.tags,
+			}
+			conn := &conns.AWSClient{}
+
+			ctx := conns.NewResourceContext(context.Background(), "Test", "aws_test", "aws_test")
+			ctx = tftags.NewContext(ctx, conn.DefaultTagsConfig, conn.IgnoreTagsConfig)
+			d := &taggedResourceData{values: make(map[string]any)}
+
+			_, diags := tagsReadFunc(ctx, d, sp, spt, "Test", "aws_test", conn, nil)
+
+			if got, want := len(diags), testCase.expectedDiags; got != want {
+				t.Fatalf("length of diags = %d, want %d: %v", got, want, diags)
+			}
+			for _, v := range diags {
+				if v.Severity != diag.Warning || v.Summary != tftags.DriftSummary {
+					t.Errorf("unexpected diagnostic: %v", v)
+				}
+			}
+		})
+	}
+}
@@ -233,7 +233,7 @@ func waitRuleGroupNamespaceCreated(ctx context.Context, conn *amp.Client, id str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.RuleGroupsNamespaceDescription); ok {
 		return output, err
@@ -253,7 +253,7 @@ func waitRuleGroupNamespaceUpdated(ctx context.Context, conn *amp.Client, id str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.RuleGroupsNamespaceDescription); ok {
 		return output, err
@@ -273,7 +273,7 @@ func waitRuleGroupNamespaceDeleted(ctx context.Context, conn *amp.Client, id str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.RuleGroupsNamespaceDescription); ok {
 		return output, err
@@ -335,7 +335,7 @@ func waitWorkspaceCreated(ctx context.Context, conn *amp.Client, id string) (*ty
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.WorkspaceDescription); ok {
 		return output, err
@@ -355,7 +355,7 @@ func waitWorkspaceUpdated(ctx context.Context, conn *amp.Client, id string) (*ty
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.WorkspaceDescription); ok {
 		return output, err
@@ -375,7 +375,7 @@ func waitWorkspaceDeleted(ctx context.Context, conn *amp.Client, id string) (*ty
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.WorkspaceDescription); ok {
 		return output, err
@@ -436,7 +436,7 @@ func waitLoggingConfigurationCreated(ctx context.Context, conn *amp.Client, work
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.LoggingConfigurationMetadata); ok {
 		if statusCode := output.Status.StatusCode; statusCode == types.LoggingConfigurationStatusCodeCreationFailed {
@@ -460,7 +460,7 @@ func waitLoggingConfigurationUpdated(ctx context.Context, conn *amp.Client, work
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.LoggingConfigurationMetadata); ok {
 		if statusCode := output.Status.StatusCode; statusCode == types.LoggingConfigurationStatusCodeUpdateFailed {
@@ -484,7 +484,7 @@ func waitLoggingConfigurationDeleted(ctx context.Context, conn *amp.Client, work
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.LoggingConfigurationMetadata); ok {
 		return output, err
@@ -470,7 +470,7 @@ func waitDomainNameUpdated(ctx context.Context, conn *apigateway.Client, domainN
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DomainName); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.DomainNameStatusMessage)))
@@ -485,7 +485,7 @@ func waitStageCacheAvailable(ctx context.Context, conn *apigateway.Client, apiID
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Stage); ok {
 		return output, err
@@ -511,7 +511,7 @@ func waitStageCacheUpdated(ctx context.Context, conn *apigateway.Client, apiID,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Stage); ok {
 		return output, err
@@ -230,7 +230,7 @@ func waitDeploymentDeployed(ctx context.Context, conn *apigatewayv2.Client, apiI
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*apigatewayv2.GetDeploymentOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.DeploymentStatusMessage)))
@@ -306,7 +306,7 @@ func waitDomainNameAvailable(ctx context.Context, conn *apigatewayv2.Client, nam
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*apigatewayv2.GetDomainNameOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.DomainNameConfigurations[0].DomainNameStatusMessage)))
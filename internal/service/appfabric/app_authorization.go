@@ -445,7 +445,7 @@ func waitAppAuthorizationCreated(ctx context.Context, conn *appfabric.Client, ap
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.AppAuthorization); ok {
 		return output, err
@@ -462,7 +462,7 @@ func waitAppAuthorizationUpdated(ctx context.Context, conn *appfabric.Client, ap
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.AppAuthorization); ok {
 		return output, err
@@ -479,7 +479,7 @@ func waitAppAuthorizationDeleted(ctx context.Context, conn *appfabric.Client, ap
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.AppAuthorization); ok {
 		return output, err
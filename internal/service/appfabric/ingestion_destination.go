@@ -498,7 +498,7 @@ func waitIngestionDestinationActive(ctx context.Context, conn *appfabric.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.IngestionDestination); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
@@ -517,7 +517,7 @@ func waitIngestionDestinationDeleted(ctx context.Context, conn *appfabric.Client
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.IngestionDestination); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
@@ -1531,7 +1531,7 @@ func waitFlowDeleted(ctx context.Context, conn *appflow.Client, name string) (*t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.FlowDefinition); ok {
 		return output, err
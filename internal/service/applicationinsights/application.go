@@ -270,7 +270,7 @@ func waitApplicationCreated(ctx context.Context, conn *applicationinsights.Clien
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ApplicationInfo); ok {
 		return output, err
@@ -290,7 +290,7 @@ func waitApplicationTerminated(ctx context.Context, conn *applicationinsights.Cl
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ApplicationInfo); ok {
 		return output, err
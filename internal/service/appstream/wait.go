@@ -41,7 +41,7 @@ func waitFleetStateRunning(ctx context.Context, conn *appstream.Client, name str
 		Timeout: fleetStateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Fleet); ok {
 		if v := output.FleetErrors; len(v) > 0 {
@@ -69,7 +69,7 @@ func waitFleetStateStopped(ctx context.Context, conn *appstream.Client, name str
 		Timeout: fleetStateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Fleet); ok {
 		if v := output.FleetErrors; len(v) > 0 {
@@ -96,7 +96,7 @@ func waitImageBuilderStateRunning(ctx context.Context, conn *appstream.Client, n
 		Timeout: imageBuilderStateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ImageBuilder); ok {
 		if state, v := output.State, output.ImageBuilderErrors; state == awstypes.ImageBuilderStateFailed && len(v) > 0 {
@@ -123,7 +123,7 @@ func waitImageBuilderStateDeleted(ctx context.Context, conn *appstream.Client, n
 		Timeout: imageBuilderStateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ImageBuilder); ok {
 		if state, v := output.State, output.ImageBuilderErrors; state == awstypes.ImageBuilderStateFailed && len(v) > 0 {
@@ -150,7 +150,7 @@ func waitUserAvailable(ctx context.Context, conn *appstream.Client, username, au
 		Timeout: userOperationTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.User); ok {
 		return output, err
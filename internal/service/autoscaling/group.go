@@ -2546,7 +2546,7 @@ func waitGroupCapacitySatisfied(ctx context.Context, conn *autoscaling.Client, e
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(struct{ err error }); ok {
 		tfresource.SetLastError(err, output.err)
@@ -2562,7 +2562,7 @@ func waitGroupDrained(ctx context.Context, conn *autoscaling.Client, name string
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.AutoScalingGroup); ok {
 		return output, err
@@ -2578,7 +2578,7 @@ func waitLoadBalancersAdded(ctx context.Context, conn *autoscaling.Client, name
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*awstypes.LoadBalancerState); ok {
 		return output, err
@@ -2594,7 +2594,7 @@ func waitLoadBalancersRemoved(ctx context.Context, conn *autoscaling.Client, nam
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*awstypes.LoadBalancerState); ok {
 		return output, err
@@ -2610,7 +2610,7 @@ func waitLoadBalancerTargetGroupsAdded(ctx context.Context, conn *autoscaling.Cl
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*awstypes.LoadBalancerTargetGroupState); ok {
 		return output, err
@@ -2626,7 +2626,7 @@ func waitLoadBalancerTargetGroupsRemoved(ctx context.Context, conn *autoscaling.
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*awstypes.LoadBalancerTargetGroupState); ok {
 		return output, err
@@ -2642,7 +2642,7 @@ func waitTrafficSourcesCreated(ctx context.Context, conn *autoscaling.Client, as
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*awstypes.TrafficSourceState); ok {
 		return output, err
@@ -2658,7 +2658,7 @@ func waitTrafficSourcesDeleted(ctx context.Context, conn *autoscaling.Client, as
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*awstypes.TrafficSourceState); ok {
 		return output, err
@@ -2693,7 +2693,7 @@ func waitInstanceRefreshCancelled(ctx context.Context, conn *autoscaling.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.InstanceRefresh); ok {
 		return output, err
@@ -2710,7 +2710,7 @@ func waitWarmPoolDeleted(ctx context.Context, conn *autoscaling.Client, name str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.WarmPoolConfiguration); ok {
 		return output, err
@@ -2726,7 +2726,7 @@ func waitWarmPoolDrained(ctx context.Context, conn *autoscaling.Client, name str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*autoscaling.DescribeWarmPoolOutput); ok {
 		return output, err
@@ -423,7 +423,7 @@ func waitReportPlanCreated(ctx context.Context, conn *backup.Client, name string
 		Refresh: statusReportPlanDeployment(ctx, conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReportPlan); ok {
 		return output, err
@@ -440,7 +440,7 @@ func waitReportPlanDeleted(ctx context.Context, conn *backup.Client, name string
 		Refresh: statusReportPlanDeployment(ctx, conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReportPlan); ok {
 		return output, err
@@ -457,7 +457,7 @@ func waitReportPlanUpdated(ctx context.Context, conn *backup.Client, name string
 		Refresh: statusReportPlanDeployment(ctx, conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReportPlan); ok {
 		return output, err
@@ -29,7 +29,7 @@ func WaitJobCompleted(ctx context.Context, conn *backup.Client, id string, timeo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*backup.DescribeBackupJobOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
@@ -48,7 +48,7 @@ func waitFrameworkCreated(ctx context.Context, conn *backup.Client, id string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*backup.DescribeFrameworkOutput); ok {
 		return output, err
@@ -65,7 +65,7 @@ func waitFrameworkUpdated(ctx context.Context, conn *backup.Client, id string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*backup.DescribeFrameworkOutput); ok {
 		return output, err
@@ -82,7 +82,7 @@ func waitFrameworkDeleted(ctx context.Context, conn *backup.Client, id string, t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*backup.DescribeFrameworkOutput); ok {
 		return output, err
@@ -99,7 +99,7 @@ func waitRecoveryPointDeleted(ctx context.Context, conn *backup.Client, backupVa
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*backup.DescribeRecoveryPointOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
@@ -750,7 +750,7 @@ func waitComputeEnvironmentCreated(ctx context.Context, conn *batch.Client, name
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ComputeEnvironmentDetail); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
@@ -769,7 +769,7 @@ func waitComputeEnvironmentUpdated(ctx context.Context, conn *batch.Client, name
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ComputeEnvironmentDetail); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
@@ -788,7 +788,7 @@ func waitComputeEnvironmentDeleted(ctx context.Context, conn *batch.Client, name
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ComputeEnvironmentDetail); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
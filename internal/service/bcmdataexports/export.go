@@ -393,7 +393,7 @@ func waitExportCreated(ctx context.Context, conn *bcmdataexports.Client, id stri
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*bcmdataexports.GetExportOutput); ok {
 		return out, err
 	}
@@ -411,7 +411,7 @@ func waitExportUpdated(ctx context.Context, conn *bcmdataexports.Client, id stri
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*bcmdataexports.GetExportOutput); ok {
 		return out, err
 	}
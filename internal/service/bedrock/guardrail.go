@@ -562,7 +562,7 @@ func waitGuardrailCreated(ctx context.Context, conn *bedrock.Client, id string,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*bedrock.GetGuardrailOutput); ok {
 		return out, err
 	}
@@ -580,7 +580,7 @@ func waitGuardrailUpdated(ctx context.Context, conn *bedrock.Client, id string,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*bedrock.GetGuardrailOutput); ok {
 		return out, err
 	}
@@ -596,7 +596,7 @@ func waitGuardrailDeleted(ctx context.Context, conn *bedrock.Client, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*bedrock.GetGuardrailOutput); ok {
 		return out, err
 	}
@@ -63,11 +63,13 @@ type setupFunc func(setup *caseSetup)
 type callFunc func(ctx context.Context, t *testing.T, meta *conns.AWSClient) apiCallParams
 
 const (
-	packageNameConfigEndpoint = "https://packagename-config.endpoint.test/"
-	awsServiceEnvvarEndpoint  = "https://service-envvar.endpoint.test/"
-	baseEnvvarEndpoint        = "https://base-envvar.endpoint.test/"
-	serviceConfigFileEndpoint = "https://service-configfile.endpoint.test/"
-	baseConfigFileEndpoint    = "https://base-configfile.endpoint.test/"
+	packageNameConfigEndpoint         = "https://packagename-config.endpoint.test/"
+	awsServiceEnvvarEndpoint          = "https://service-envvar.endpoint.test/"
+	baseEnvvarEndpoint                = "https://base-envvar.endpoint.test/"
+	serviceConfigFileEndpoint         = "https://service-configfile.endpoint.test/"
+	baseConfigFileEndpoint            = "https://base-configfile.endpoint.test/"
+	endpointURLConfigEndpoint         = "https://endpoint-url-config.endpoint.test/"
+	endpointURLTemplateConfigEndpoint = "https://{service}.{region}.endpoint-url-template.test/"
 )
 
 const (
@@ -134,6 +136,88 @@ func TestEndpointConfiguration(t *testing.T) { //nolint:paralleltest // uses t.S
 			expected: expectPackageNameConfigEndpoint(),
 		},
 
+		// endpoint_url on Config
+
+		"endpoint_url config": {
+			with: []setupFunc{
+				withEndpointURLInConfig,
+			},
+			expected: expectEndpointURLConfigEndpoint(),
+		},
+
+		"package name endpoint config overrides endpoint_url config": {
+			with: []setupFunc{
+				withPackageNameEndpointInConfig,
+				withEndpointURLInConfig,
+			},
+			expected: expectPackageNameConfigEndpoint(),
+		},
+
+		"endpoint_url config overrides aws service envvar": {
+			with: []setupFunc{
+				withEndpointURLInConfig,
+				withAwsEnvVar,
+			},
+			expected: expectEndpointURLConfigEndpoint(),
+		},
+
+		"endpoint_url config overrides base envvar": {
+			with: []setupFunc{
+				withEndpointURLInConfig,
+				withBaseEnvVar,
+			},
+			expected: expectEndpointURLConfigEndpoint(),
+		},
+
+		"endpoint_url config overrides service config file": {
+			with: []setupFunc{
+				withEndpointURLInConfig,
+				withServiceEndpointInConfigFile,
+			},
+			expected: expectEndpointURLConfigEndpoint(),
+		},
+
+		"endpoint_url config overrides base config file": {
+			with: []setupFunc{
+				withEndpointURLInConfig,
+				withBaseEndpointInConfigFile,
+			},
+			expected: expectEndpointURLConfigEndpoint(),
+		},
+
+		"use fips config with endpoint_url config": {
+			with: []setupFunc{
+				withUseFIPSInConfig,
+				withEndpointURLInConfig,
+			},
+			expected: expectEndpointURLConfigEndpoint(),
+		},
+
+		// endpoint_url_template on Config
+
+		"endpoint_url_template config": {
+			with: []setupFunc{
+				withEndpointURLTemplateInConfig,
+			},
+			expected: expectEndpointURLTemplateConfigEndpoint(providerRegion),
+		},
+
+		"package name endpoint config overrides endpoint_url_template config": {
+			with: []setupFunc{
+				withPackageNameEndpointInConfig,
+				withEndpointURLTemplateInConfig,
+			},
+			expected: expectPackageNameConfigEndpoint(),
+		},
+
+		"endpoint_url_template config overrides aws service envvar": {
+			with: []setupFunc{
+				withEndpointURLTemplateInConfig,
+				withAwsEnvVar,
+			},
+			expected: expectEndpointURLTemplateConfigEndpoint(providerRegion),
+		},
+
 		// Service endpoint in AWS envvar
 
 		"service aws envvar": {
@@ -335,6 +419,14 @@ func withBaseEndpointInConfigFile(setup *caseSetup) {
 	setup.configFile.baseUrl = baseConfigFileEndpoint
 }
 
+func withEndpointURLInConfig(setup *caseSetup) {
+	setup.config["endpoint_url"] = endpointURLConfigEndpoint
+}
+
+func withEndpointURLTemplateInConfig(setup *caseSetup) {
+	setup.config["endpoint_url_template"] = endpointURLTemplateConfigEndpoint
+}
+
 func withUseFIPSInConfig(setup *caseSetup) {
 	setup.config["use_fips_endpoint"] = true
 }
@@ -396,6 +488,20 @@ func expectBaseEnvVarEndpoint() caseExpectations {
 	}
 }
 
+func expectEndpointURLConfigEndpoint() caseExpectations {
+	return caseExpectations{
+		endpoint: endpointURLConfigEndpoint,
+		region:   expectedCallRegion,
+	}
+}
+
+func expectEndpointURLTemplateConfigEndpoint(region string) caseExpectations {
+	return caseExpectations{
+		endpoint: strings.NewReplacer("{service}", packageName, "{region}", region).Replace(endpointURLTemplateConfigEndpoint),
+		region:   expectedCallRegion,
+	}
+}
+
 func expectServiceConfigFileEndpoint() caseExpectations {
 	return caseExpectations{
 		endpoint: serviceConfigFileEndpoint,
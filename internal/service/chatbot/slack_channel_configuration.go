@@ -369,7 +369,7 @@ func waitSlackChannelConfigurationAvailable(ctx context.Context, conn *chatbot.C
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SlackChannelConfiguration); ok {
 		return output, err
@@ -388,7 +388,7 @@ func waitSlackChannelConfigurationDeleted(ctx context.Context, conn *chatbot.Cli
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SlackChannelConfiguration); ok {
 		return output, err
@@ -416,7 +416,7 @@ func waitProgressEventOperationStatusSuccess(ctx context.Context, conn *cloudcon
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.ProgressEvent); ok {
 		if output.OperationStatus == types.OperationStatusFailed {
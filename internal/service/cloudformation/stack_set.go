@@ -566,7 +566,7 @@ func waitStackSetCreated(ctx context.Context, conn *cloudformation.Client, name,
 		Delay:   15 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf)
 
 	if output, ok := outputRaw.(*awstypes.StackSet); ok {
 		return output, err
@@ -661,7 +661,7 @@ func waitStackSetOperationSucceeded(ctx context.Context, conn *cloudformation.Cl
 		Delay:   stackSetOperationDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.StackSetOperation); ok {
 		if output.Status == awstypes.StackSetOperationStatusFailed {
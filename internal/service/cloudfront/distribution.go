@@ -1241,7 +1241,7 @@ func waitDistributionDeployed(ctx context.Context, conn *cloudfront.Client, id s
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudfront.GetDistributionOutput); ok {
 		return output, err
@@ -1260,7 +1260,7 @@ func waitDistributionDeleted(ctx context.Context, conn *cloudfront.Client, id st
 		Delay:      15 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudfront.GetDistributionOutput); ok {
 		return output, err
@@ -234,7 +234,7 @@ func waitHSMCreated(ctx context.Context, conn *cloudhsmv2.Client, id string, tim
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Hsm); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateMessage)))
@@ -255,7 +255,7 @@ func waitHSMDeleted(ctx context.Context, conn *cloudhsmv2.Client, id string, tim
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Hsm); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateMessage)))
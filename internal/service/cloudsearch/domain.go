@@ -708,7 +708,7 @@ func waitDomainActive(ctx context.Context, conn *cloudsearch.Client, name string
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DomainStatus); ok {
 		return output, err
@@ -725,7 +725,7 @@ func waitDomainDeleted(ctx context.Context, conn *cloudsearch.Client, name strin
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DomainStatus); ok {
 		return output, err
@@ -420,7 +420,7 @@ func waitMetricStreamDeleted(ctx context.Context, conn *cloudwatch.Client, name
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudwatch.GetMetricStreamOutput); ok {
 		return output, err
@@ -437,7 +437,7 @@ func waitMetricStreamRunning(ctx context.Context, conn *cloudwatch.Client, name
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*cloudwatch.GetMetricStreamOutput); ok {
 		return output, err
@@ -274,7 +274,7 @@ func waitReportGroupDeleted(ctx context.Context, conn *codebuild.Client, arn str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.ReportGroup); ok {
 		return output, err
@@ -276,7 +276,7 @@ func waitDevEnvironmentCreated(ctx context.Context, conn *codecatalyst.Client, i
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*codecatalyst.GetDevEnvironmentOutput); ok {
 		return out, err
 	}
@@ -294,7 +294,7 @@ func waitDevEnvironmentUpdated(ctx context.Context, conn *codecatalyst.Client, i
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*codecatalyst.GetDevEnvironmentOutput); ok {
 		return out, err
 	}
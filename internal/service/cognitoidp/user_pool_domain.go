@@ -261,7 +261,7 @@ func waitUserPoolDomainCreated(ctx context.Context, conn *cognitoidentityprovide
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DomainDescriptionType); ok {
 		return output, err
@@ -278,7 +278,7 @@ func waitUserPoolDomainUpdated(ctx context.Context, conn *cognitoidentityprovide
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DomainDescriptionType); ok {
 		return output, err
@@ -295,7 +295,7 @@ func waitUserPoolDomainDeleted(ctx context.Context, conn *cognitoidentityprovide
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DomainDescriptionType); ok {
 		return output, err
@@ -333,7 +333,7 @@ func waitInstanceCreated(ctx context.Context, conn *connect.Connect, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*connect.Instance); ok {
 		if output.StatusReason != nil {
@@ -353,7 +353,7 @@ func waitInstanceDeleted(ctx context.Context, conn *connect.Connect, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*connect.Instance); ok {
 		return output, err
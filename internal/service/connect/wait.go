@@ -35,7 +35,7 @@ func waitPhoneNumberCreated(ctx context.Context, conn *connect.Connect, timeout
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*connect.DescribePhoneNumberOutput); ok {
 		if aws.StringValue(output.ClaimedPhoneNumberSummary.PhoneNumberStatus.Status) == connect.PhoneNumberWorkflowStatusFailed {
@@ -55,7 +55,7 @@ func waitPhoneNumberUpdated(ctx context.Context, conn *connect.Connect, timeout
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*connect.DescribePhoneNumberOutput); ok {
 		if aws.StringValue(output.ClaimedPhoneNumberSummary.PhoneNumberStatus.Status) == connect.PhoneNumberWorkflowStatusFailed {
@@ -75,7 +75,7 @@ func waitPhoneNumberDeleted(ctx context.Context, conn *connect.Connect, timeout
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*connect.DescribePhoneNumberOutput); ok {
 		return v, err
@@ -92,7 +92,7 @@ func waitVocabularyCreated(ctx context.Context, conn *connect.Connect, timeout t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*connect.DescribeVocabularyOutput); ok {
 		return v, err
@@ -109,7 +109,7 @@ func waitVocabularyDeleted(ctx context.Context, conn *connect.Connect, timeout t
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*connect.DescribeVocabularyOutput); ok {
 		return v, err
@@ -333,7 +333,7 @@ func waitProjectCreated(ctx context.Context, conn *datazone.Client, domain strin
 		ContinuousTargetOccurence: 10,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*datazone.GetProjectOutput); ok {
 		return out, err
 	}
@@ -349,7 +349,7 @@ func waitProjectDeleted(ctx context.Context, conn *datazone.Client, domain strin
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*datazone.GetProjectOutput); ok {
 		return out, err
 	}
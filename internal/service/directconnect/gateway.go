@@ -235,7 +235,7 @@ func waitGatewayCreated(ctx context.Context, conn *directconnect.Client, id stri
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DirectConnectGateway); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateChangeError)))
@@ -254,7 +254,7 @@ func waitGatewayDeleted(ctx context.Context, conn *directconnect.Client, id stri
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DirectConnectGateway); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateChangeError)))
@@ -318,7 +318,7 @@ func waitLagDeleted(ctx context.Context, conn *directconnect.Client, id string)
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Lag); ok {
 		return output, err
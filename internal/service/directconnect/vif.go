@@ -149,7 +149,7 @@ func waitVirtualInterfaceAvailable(ctx context.Context, conn *directconnect.Clie
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VirtualInterface); ok {
 		return output, err
@@ -176,7 +176,7 @@ func waitVirtualInterfaceDeleted(ctx context.Context, conn *directconnect.Client
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VirtualInterface); ok {
 		return output, err
@@ -2688,7 +2688,7 @@ func waitEndpointDeleted(ctx context.Context, conn *dms.Client, id string, timeo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Endpoint); ok {
 		return output, err
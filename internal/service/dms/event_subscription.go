@@ -282,7 +282,7 @@ func waitEventSubscriptionCreated(ctx context.Context, conn *dms.Client, name st
 		Delay:      10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.EventSubscription); ok {
 		return output, err
@@ -301,7 +301,7 @@ func waitEventSubscriptionUpdated(ctx context.Context, conn *dms.Client, name st
 		Delay:      10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.EventSubscription); ok {
 		return output, err
@@ -320,7 +320,7 @@ func waitEventSubscriptionDeleted(ctx context.Context, conn *dms.Client, name st
 		Delay:      10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.EventSubscription); ok {
 		return output, err
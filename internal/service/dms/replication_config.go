@@ -491,7 +491,7 @@ func waitReplicationRunning(ctx context.Context, conn *dms.Client, arn string, t
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Replication); ok {
 		setLastReplicationError(err, output)
@@ -511,7 +511,7 @@ func waitReplicationStopped(ctx context.Context, conn *dms.Client, arn string, t
 		Delay:      60 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Replication); ok {
 		setLastReplicationError(err, output)
@@ -531,7 +531,7 @@ func waitReplicationDeleted(ctx context.Context, conn *dms.Client, arn string, t
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Replication); ok {
 		setLastReplicationError(err, output)
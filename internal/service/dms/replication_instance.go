@@ -405,7 +405,7 @@ func waitReplicationInstanceCreated(ctx context.Context, conn *dms.Client, id st
 		Delay:      30 * time.Second, // Wait 30 secs before starting
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReplicationInstance); ok {
 		return output, err
@@ -424,7 +424,7 @@ func waitReplicationInstanceUpdated(ctx context.Context, conn *dms.Client, id st
 		Delay:      30 * time.Second, // Wait 30 secs before starting
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReplicationInstance); ok {
 		return output, err
@@ -443,7 +443,7 @@ func waitReplicationInstanceDeleted(ctx context.Context, conn *dms.Client, id st
 		Delay:      30 * time.Second, // Wait 30 secs before starting
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReplicationInstance); ok {
 		return output, err
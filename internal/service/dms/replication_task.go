@@ -434,7 +434,7 @@ func waitReplicationTaskDeleted(ctx context.Context, conn *dms.Client, id string
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReplicationTask); ok {
 		setLastReplicationTaskError(err, output)
@@ -454,7 +454,7 @@ func waitReplicationTaskModified(ctx context.Context, conn *dms.Client, id strin
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReplicationTask); ok {
 		setLastReplicationTaskError(err, output)
@@ -474,7 +474,7 @@ func waitReplicationTaskMoved(ctx context.Context, conn *dms.Client, id string,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReplicationTask); ok {
 		setLastReplicationTaskError(err, output)
@@ -494,7 +494,7 @@ func waitReplicationTaskReady(ctx context.Context, conn *dms.Client, id string,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReplicationTask); ok {
 		setLastReplicationTaskError(err, output)
@@ -517,7 +517,7 @@ func waitReplicationTaskRunning(ctx context.Context, conn *dms.Client, id string
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReplicationTask); ok {
 		setLastReplicationTaskError(err, output)
@@ -541,7 +541,7 @@ func waitReplicationTaskStopped(ctx context.Context, conn *dms.Client, id string
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReplicationTask); ok {
 		setLastReplicationTaskError(err, output)
@@ -565,7 +565,7 @@ func waitReplicationTaskSteady(ctx context.Context, conn *dms.Client, id string)
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReplicationTask); ok {
 		setLastReplicationTaskError(err, output)
@@ -955,7 +955,7 @@ func waitDBClusterAvailable(ctx context.Context, conn *docdb.Client, id string,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DBCluster); ok {
 		return output, err
@@ -979,7 +979,7 @@ func waitDBClusterDeleted(ctx context.Context, conn *docdb.Client, id string, ti
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DBCluster); ok {
 		return output, err
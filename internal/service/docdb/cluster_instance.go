@@ -478,7 +478,7 @@ func waitDBInstanceAvailable(ctx context.Context, conn *docdb.Client, id string,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DBInstance); ok {
 		return output, err
@@ -501,7 +501,7 @@ func waitDBInstanceDeleted(ctx context.Context, conn *docdb.Client, id string, t
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DBInstance); ok {
 		return output, err
@@ -363,7 +363,7 @@ func waitEventSubscriptionCreated(ctx context.Context, conn *docdb.Client, name
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.EventSubscription); ok {
 		return output, err
@@ -382,7 +382,7 @@ func waitEventSubscriptionUpdated(ctx context.Context, conn *docdb.Client, name
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.EventSubscription); ok {
 		return output, err
@@ -401,7 +401,7 @@ func waitEventSubscriptionDeleted(ctx context.Context, conn *docdb.Client, name
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.EventSubscription); ok {
 		return output, err
@@ -385,7 +385,7 @@ func waitReplicationConfigurationTemplateAvailable(ctx context.Context, conn *dr
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReplicationConfigurationTemplate); ok {
 		return output, err
@@ -404,7 +404,7 @@ func waitReplicationConfigurationTemplateDeleted(ctx context.Context, conn *drs.
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReplicationConfigurationTemplate); ok {
 		return output, err
@@ -707,7 +707,7 @@ func waitDirectoryCreated(ctx context.Context, conn *directoryservice.Client, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	// Wrap any error returned with waiting message
 	defer func() {
@@ -735,7 +735,7 @@ func waitDirectoryDeleted(ctx context.Context, conn *directoryservice.Client, id
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	// Wrap any error returned with waiting message
 	defer func() {
@@ -833,7 +833,7 @@ func waitDomainControllerCreated(ctx context.Context, conn *directoryservice.Cli
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DomainController); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
@@ -852,7 +852,7 @@ func waitDomainControllerDeleted(ctx context.Context, conn *directoryservice.Cli
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DomainController); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
@@ -245,7 +245,7 @@ func waitRadiusCompleted(ctx context.Context, conn *directoryservice.Client, dir
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DirectoryDescription); ok {
 		return output, err
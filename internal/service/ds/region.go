@@ -350,7 +350,7 @@ func waitRegionCreated(ctx context.Context, conn *directoryservice.Client, direc
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.RegionDescription); ok {
 		return output, err
@@ -367,7 +367,7 @@ func waitRegionDeleted(ctx context.Context, conn *directoryservice.Client, direc
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.RegionDescription); ok {
 		return output, err
@@ -287,7 +287,7 @@ func waitSharedDirectoryDeleted(ctx context.Context, conn *directoryservice.Clie
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SharedDirectory); ok {
 		return output, err
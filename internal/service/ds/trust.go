@@ -494,7 +494,7 @@ func waitTrustCreated(ctx context.Context, conn *directoryservice.Client, direct
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	// Wrap any error returned with waiting message
 	defer func() {
@@ -528,7 +528,7 @@ func waitTrustVerified(ctx context.Context, conn *directoryservice.Client, direc
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	// Wrap any error returned with waiting message
 	defer func() {
@@ -561,7 +561,7 @@ func waitTrustUpdated(ctx context.Context, conn *directoryservice.Client, direct
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	// Wrap any error returned with waiting message
 	defer func() {
@@ -591,7 +591,7 @@ func waitTrustDeleted(ctx context.Context, conn *directoryservice.Client, direct
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	// Wrap any error returned with waiting message
 	defer func() {
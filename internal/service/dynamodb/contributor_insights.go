@@ -244,7 +244,7 @@ func waitContributorInsightsCreated(ctx context.Context, conn *dynamodb.Client,
 		Refresh: statusContributorInsights(ctx, conn, tableName, indexName),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.DescribeContributorInsightsOutput); ok {
 		if status, failureException := output.ContributorInsightsStatus, output.FailureException; status == awstypes.ContributorInsightsStatusFailed && failureException != nil {
@@ -265,7 +265,7 @@ func waitContributorInsightsDeleted(ctx context.Context, conn *dynamodb.Client,
 		Refresh: statusContributorInsights(ctx, conn, tableName, indexName),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.DescribeContributorInsightsOutput); ok {
 		if status, failureException := output.ContributorInsightsStatus, output.FailureException; status == awstypes.ContributorInsightsStatusFailed && failureException != nil {
@@ -11,6 +11,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -34,7 +35,7 @@ func waitTableActive(ctx context.Context, conn *dynamodb.Client, tableName strin
 		Timeout: max(createTableTimeout, timeout),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TableDescription); ok {
 		return output, err
@@ -51,7 +52,7 @@ func waitTableDeleted(ctx context.Context, conn *dynamodb.Client, tableName stri
 		Timeout: max(deleteTableTimeout, timeout),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TableDescription); ok {
 		return output, err
@@ -68,7 +69,7 @@ func waitImportComplete(ctx context.Context, conn *dynamodb.Client, importARN st
 		Timeout: max(createTableTimeout, timeout),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ImportTableDescription); ok {
 		return output, err
@@ -85,7 +86,7 @@ func waitReplicaActive(ctx context.Context, conn *dynamodb.Client, tableName, re
 		Timeout: max(replicaUpdateTimeout, timeout),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TableDescription); ok {
 		return output, err
@@ -107,7 +108,7 @@ func waitReplicaDeleted(ctx context.Context, conn *dynamodb.Client, tableName, r
 		Timeout: max(replicaUpdateTimeout, timeout),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TableDescription); ok {
 		return output, err
@@ -124,7 +125,7 @@ func waitGSIActive(ctx context.Context, conn *dynamodb.Client, tableName, indexN
 		Timeout: max(updateTableTimeout, timeout),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.GlobalSecondaryIndexDescription); ok {
 		return output, err
@@ -141,7 +142,7 @@ func waitGSIDeleted(ctx context.Context, conn *dynamodb.Client, tableName, index
 		Timeout: max(updateTableTimeout, timeout),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.GlobalSecondaryIndexDescription); ok {
 		return output, err
@@ -170,7 +171,7 @@ func waitPITRUpdated(ctx context.Context, conn *dynamodb.Client, tableName strin
 		MinTimeout: 15 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.PointInTimeRecoveryDescription); ok {
 		return output, err
@@ -195,7 +196,7 @@ func waitTTLUpdated(ctx context.Context, conn *dynamodb.Client, tableName string
 		Refresh: statusTTL(ctx, conn, tableName),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TimeToLiveDescription); ok {
 		return output, err
@@ -213,7 +214,7 @@ func waitSSEUpdated(ctx context.Context, conn *dynamodb.Client, tableName string
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TableDescription); ok {
 		return output, err
@@ -233,7 +234,7 @@ func waitReplicaSSEUpdated(ctx context.Context, conn *dynamodb.Client, region, t
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TableDescription); ok {
 		return output, err
@@ -286,7 +286,7 @@ func waitVolumeAttachmentInstanceStopped(ctx context.Context, conn *ec2.Client,
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Instance); ok {
 		if stateReason := output.StateReason; stateReason != nil {
@@ -309,7 +309,7 @@ func waitVolumeAttachmentInstanceReady(ctx context.Context, conn *ec2.Client, id
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Instance); ok {
 		if stateReason := output.StateReason; stateReason != nil {
@@ -332,7 +332,7 @@ func waitVolumeAttachmentDeleted(ctx context.Context, conn *ec2.Client, volumeID
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VolumeAttachment); ok {
 		return output, err
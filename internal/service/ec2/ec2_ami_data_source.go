@@ -6,6 +6,7 @@ package ec2
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
 	"time"
 
@@ -249,7 +250,9 @@ func dataSourceAMIRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		input.Owners = flex.ExpandStringValueList(v.([]interface{}))
 	}
 
-	images, err := findImages(ctx, conn, input)
+	images, err := conns.CachedLookup(ctx, meta.(*conns.AWSClient), names.EC2, "DescribeImages", input, func(ctx context.Context) ([]awstypes.Image, error) {
+		return findImages(ctx, conn, input)
+	})
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading EC2 AMIs: %s", err)
@@ -273,7 +276,7 @@ func dataSourceAMIRead(ctx context.Context, d *schema.ResourceData, meta interfa
 			}
 		}
 	} else {
-		filteredImages = images[:]
+		filteredImages = slices.Clone(images) // Cached lookup results must not be modified.
 	}
 
 	if len(filteredImages) < 1 {
@@ -6,6 +6,7 @@ package ec2
 import (
 	"context"
 	"log"
+	"slices"
 	"sort"
 	"time"
 
@@ -102,13 +103,16 @@ func dataSourceAvailabilityZonesRead(ctx context.Context, d *schema.ResourceData
 	}
 
 	log.Printf("[DEBUG] Reading Availability Zones: %s", d.Id())
-	resp, err := conn.DescribeAvailabilityZones(ctx, request)
+	availabilityZones, err := conns.CachedLookup(ctx, meta.(*conns.AWSClient), names.EC2, "DescribeAvailabilityZones", request, func(ctx context.Context) ([]awstypes.AvailabilityZone, error) {
+		return findAvailabilityZones(ctx, conn, request)
+	})
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "fetching Availability Zones: %s", err)
 	}
 
-	sort.Slice(resp.AvailabilityZones, func(i, j int) bool {
-		return aws.ToString(resp.AvailabilityZones[i].ZoneName) < aws.ToString(resp.AvailabilityZones[j].ZoneName)
+	availabilityZones = slices.Clone(availabilityZones) // Cached lookup results must not be modified.
+	sort.Slice(availabilityZones, func(i, j int) bool {
+		return aws.ToString(availabilityZones[i].ZoneName) < aws.ToString(availabilityZones[j].ZoneName)
 	})
 
 	excludeNames := d.Get("exclude_names").(*schema.Set)
@@ -117,7 +121,7 @@ func dataSourceAvailabilityZonesRead(ctx context.Context, d *schema.ResourceData
 	groupNames := schema.NewSet(schema.HashString, nil)
 	nms := []string{}
 	zoneIds := []string{}
-	for _, v := range resp.AvailabilityZones {
+	for _, v := range availabilityZones {
 		groupName := aws.ToString(v.GroupName)
 		name := aws.ToString(v.ZoneName)
 		zoneID := aws.ToString(v.ZoneId)
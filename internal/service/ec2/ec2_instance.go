@@ -818,7 +818,7 @@ func resourceInstance() *schema.Resource {
 						return ""
 					}
 				},
-				ValidateFunc: validation.StringLenBetween(0, 16384),
+				ValidateFunc: validation.StringLenBetween(0, names.EC2UserDataMaxLength),
 			},
 			"user_data_base64": {
 				Type:          schema.TypeString,
@@ -972,7 +972,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 	tagSpecifications := getTagSpecificationsIn(ctx, awstypes.ResourceTypeInstance)
 
 	// block devices
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	defaultTagsConfig := meta.(*conns.AWSClient).ResourceDefaultTagsConfig(ctx)
 	tagSpecifications = append(tagSpecifications,
 		tagSpecificationsFromKeyValue(
 			defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("volume_tags").(map[string]interface{}))),
@@ -1330,7 +1330,7 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 			return sdkdiag.AppendErrorf(diags, "reading EC2 Instance (%s): %s", d.Id(), err)
 		}
 
-		defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+		defaultTagsConfig := meta.(*conns.AWSClient).ResourceDefaultTagsConfig(ctx)
 		ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 		tags := keyValueTags(ctx, volumeTags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
@@ -2318,7 +2318,7 @@ func readBlockDevicesFromInstance(ctx context.Context, d *schema.ResourceData, m
 		return nil, err
 	}
 
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	defaultTagsConfig := meta.(*conns.AWSClient).ResourceDefaultTagsConfig(ctx)
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	for _, vol := range volResp.Volumes {
@@ -3201,7 +3201,7 @@ func waitInstanceCreated(ctx context.Context, conn *ec2.Client, id string, timeo
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Instance); ok {
 		if stateReason := output.StateReason; stateReason != nil {
@@ -3230,7 +3230,7 @@ func waitInstanceDeleted(ctx context.Context, conn *ec2.Client, id string, timeo
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Instance); ok {
 		if stateReason := output.StateReason; stateReason != nil {
@@ -3253,7 +3253,7 @@ func waitInstanceReady(ctx context.Context, conn *ec2.Client, id string, timeout
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Instance); ok {
 		if stateReason := output.StateReason; stateReason != nil {
@@ -3276,7 +3276,7 @@ func waitInstanceStarted(ctx context.Context, conn *ec2.Client, id string, timeo
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Instance); ok {
 		if stateReason := output.StateReason; stateReason != nil {
@@ -3304,7 +3304,7 @@ func waitInstanceStopped(ctx context.Context, conn *ec2.Client, id string, timeo
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Instance); ok {
 		if stateReason := output.StateReason; stateReason != nil {
@@ -46,6 +46,7 @@ const (
 	errCodeInvalidFlowLogIdNotFound                                = "InvalidFlowLogId.NotFound"
 	errCodeInvalidGatewayIDNotFound                                = "InvalidGatewayID.NotFound"
 	errCodeInvalidGroupInUse                                       = "InvalidGroup.InUse"
+	errCodeInvalidGroupIdMalformed                                 = "InvalidGroupId.Malformed"
 	errCodeInvalidGroupNotFound                                    = "InvalidGroup.NotFound"
 	errCodeInvalidHostIDNotFound                                   = "InvalidHostID.NotFound"
 	errCodeInvalidIPAMIdNotFound                                   = "InvalidIpamId.NotFound"
@@ -69,6 +70,7 @@ const (
 	errCodeInvalidNetworkACLIDNotFound                             = "InvalidNetworkAclID.NotFound"
 	errCodeInvalidNetworkInsightsAnalysisIdNotFound                = "InvalidNetworkInsightsAnalysisId.NotFound"
 	errCodeInvalidNetworkInsightsPathIdNotFound                    = "InvalidNetworkInsightsPathId.NotFound"
+	errCodeInvalidNetworkInterfaceIDMalformed                      = "InvalidNetworkInterfaceID.Malformed"
 	errCodeInvalidNetworkInterfaceIDNotFound                       = "InvalidNetworkInterfaceID.NotFound"
 	errCodeInvalidParameter                                        = "InvalidParameter"
 	errCodeInvalidParameterCombination                             = "InvalidParameterCombination"
@@ -96,6 +98,7 @@ const (
 	errCodeInvalidSpotFleetRequestIdNotFound                       = "InvalidSpotFleetRequestId.NotFound"
 	errCodeInvalidSpotInstanceRequestIDNotFound                    = "InvalidSpotInstanceRequestID.NotFound"
 	errCodeInvalidSubnetCIDRReservationIDNotFound                  = "InvalidSubnetCidrReservationID.NotFound"
+	errCodeInvalidSubnetIDMalformed                                = "InvalidSubnetID.Malformed"
 	errCodeInvalidSubnetIDNotFound                                 = "InvalidSubnetID.NotFound"
 	errCodeInvalidSubnetIdNotFound                                 = "InvalidSubnetId.NotFound"
 	errCodeInvalidTrafficMirrorFilterIdNotFound                    = "InvalidTrafficMirrorFilterId.NotFound"
@@ -1259,25 +1259,10 @@ func findSpotPrice(ctx context.Context, conn *ec2.Client, input *ec2.DescribeSpo
 	return tfresource.AssertSingleValueResult(output)
 }
 
+// findSubnetByID looks up a subnet by ID. Returns a retry.NotFoundError if not found.
+// Concurrent lookups are coalesced into a single Describe call.
 func findSubnetByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.Subnet, error) {
-	input := &ec2.DescribeSubnetsInput{
-		SubnetIds: []string{id},
-	}
-
-	output, err := findSubnet(ctx, conn, input)
-
-	if err != nil {
-		return nil, err
-	}
-
-	// Eventual consistency check.
-	if aws.ToString(output.SubnetId) != id {
-		return nil, &retry.NotFoundError{
-			LastRequest: input,
-		}
-	}
-
-	return output, nil
+	return subnetBatcher.find(ctx, conn, id)
 }
 
 func findSubnet(ctx context.Context, conn *ec2.Client, input *ec2.DescribeSubnetsInput) (*awstypes.Subnet, error) {
@@ -1921,25 +1906,10 @@ func findSecurityGroupByNameAndVPCID(ctx context.Context, conn *ec2.Client, name
 	return findSecurityGroup(ctx, conn, input)
 }
 
+// findSecurityGroupByID looks up a security group by ID. Returns a retry.NotFoundError if not found.
+// Concurrent lookups are coalesced into a single Describe call.
 func findSecurityGroupByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.SecurityGroup, error) {
-	input := &ec2.DescribeSecurityGroupsInput{
-		GroupIds: []string{id},
-	}
-
-	output, err := findSecurityGroup(ctx, conn, input)
-
-	if err != nil {
-		return nil, err
-	}
-
-	// Eventual consistency check.
-	if aws.ToString(output.GroupId) != id {
-		return nil, &retry.NotFoundError{
-			LastRequest: input,
-		}
-	}
-
-	return output, nil
+	return securityGroupBatcher.find(ctx, conn, id)
 }
 
 func findSecurityGroupByDescriptionAndVPCID(ctx context.Context, conn *ec2.Client, description, vpcID string) (*awstypes.SecurityGroup, error) {
@@ -2094,25 +2064,10 @@ func findNetworkInterface(ctx context.Context, conn *ec2.Client, input *ec2.Desc
 	return tfresource.AssertSingleValueResult(output)
 }
 
+// findNetworkInterfaceByID looks up a network interface by ID. Returns a retry.NotFoundError if not found.
+// Concurrent lookups are coalesced into a single Describe call.
 func findNetworkInterfaceByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.NetworkInterface, error) {
-	input := &ec2.DescribeNetworkInterfacesInput{
-		NetworkInterfaceIds: []string{id},
-	}
-
-	output, err := findNetworkInterface(ctx, conn, input)
-
-	if err != nil {
-		return nil, err
-	}
-
-	// Eventual consistency check.
-	if aws.ToString(output.NetworkInterfaceId) != id {
-		return nil, &retry.NotFoundError{
-			LastRequest: input,
-		}
-	}
-
-	return output, err
+	return networkInterfaceBatcher.find(ctx, conn, id)
 }
 
 func findNetworkInterfaceAttachmentByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.NetworkInterfaceAttachment, error) {
@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
+)
+
+// Request coalescing for Describe* lookups by ID.
+// Concurrent lookups by ID of the same resource type, using the same API client, that start within a short window
+// are combined into a single Describe call with many IDs. Each caller receives only its own result.
+
+const (
+	describeBatchWindow  = 10 * time.Millisecond
+	describeBatchMaxSize = 200
+)
+
+// describeBatcher coalesces lookups by ID into Describe calls.
+// I is the Describe operation's input type and T its result element type.
+type describeBatcher[I, T any] struct {
+	describe   func(context.Context, *ec2.Client, I) ([]T, error)
+	id         func(T) string
+	idErrCodes []string // Error codes caused by a single ID.
+	input      func([]string) I
+	mu         sync.Mutex
+	pending    map[*ec2.Client]*describeBatch[T]
+	window     time.Duration
+}
+
+// describeBatch is a pending or in-progress Describe call.
+type describeBatch[T any] struct {
+	ctx     context.Context // From the first caller, without cancellation or per-resource values.
+	done    chan struct{}
+	errs    map[string]error
+	ids     []string
+	results map[string]T
+}
+
+// newDescribeBatcher returns a new describeBatcher.
+// idErrCodes are the error codes, such as "InvalidGroupId.Malformed", that a Describe call fails with because of a single ID.
+func newDescribeBatcher[I, T any](input func([]string) I, describe func(context.Context, *ec2.Client, I) ([]T, error), id func(T) string, idErrCodes ...string) *describeBatcher[I, T] {
+	return &describeBatcher[I, T]{
+		describe:   describe,
+		id:         id,
+		idErrCodes: idErrCodes,
+		input:      input,
+		pending:    make(map[*ec2.Client]*describeBatch[T]),
+		window:     describeBatchWindow,
+	}
+}
+
+// find returns the resource with the specified ID.
+// Returns a retry.NotFoundError if the resource is not found.
+func (b *describeBatcher[I, T]) find(ctx context.Context, conn *ec2.Client, id string) (*T, error) {
+	batch := b.add(ctx, conn, id)
+
+	select {
+	case <-batch.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if err, ok := batch.errs[id]; ok {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	output, ok := batch.results[id]
+	if !ok {
+		return nil, &retry.NotFoundError{
+			LastRequest: b.input([]string{id}),
+		}
+	}
+
+	return &output, nil
+}
+
+// add adds the ID to the API client's pending batch, starting a new batch if necessary.
+func (b *describeBatcher[I, T]) add(ctx context.Context, conn *ec2.Client, id string) *describeBatch[T] {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	batch, ok := b.pending[conn]
+	if !ok {
+		batch = &describeBatch[T]{
+			ctx:  batchContext{context.WithoutCancel(ctx)},
+			done: make(chan struct{}),
+		}
+		b.pending[conn] = batch
+		time.AfterFunc(b.window, func() {
+			b.run(conn, batch)
+		})
+	}
+
+	if !slices.Contains(batch.ids, id) {
+		batch.ids = append(batch.ids, id)
+	}
+
+	// A full batch accepts no more IDs.
+	if len(batch.ids) >= describeBatchMaxSize {
+		delete(b.pending, conn)
+	}
+
+	return batch
+}
+
+// run makes the batch's Describe call.
+func (b *describeBatcher[I, T]) run(conn *ec2.Client, batch *describeBatch[T]) {
+	b.mu.Lock()
+	if b.pending[conn] == batch {
+		delete(b.pending, conn)
+	}
+	ids := batch.ids
+	b.mu.Unlock()
+
+	defer close(batch.done)
+
+	ctx := batch.ctx
+	batch.errs = make(map[string]error)
+	batch.results = make(map[string]T, len(ids))
+
+	tflog.Debug(ctx, "Describing batch", map[string]any{
+		"tf_aws.ec2.batch_size": len(ids),
+	})
+
+	output, err := b.describe(ctx, conn, b.input(ids))
+
+	// A single missing or malformed ID fails a Describe call with many IDs.
+	// Describe each ID separately so that only that ID's callers see the error.
+	// Any other error, e.g. throttling, applies to the whole call and is returned to all callers
+	// rather than multiplying the number of calls.
+	if err != nil && len(ids) > 1 && b.isIDError(err) {
+		for _, id := range ids {
+			output, err := b.describe(ctx, conn, b.input([]string{id}))
+
+			if err != nil {
+				batch.errs[id] = err
+				continue
+			}
+
+			b.addResults(batch, output)
+		}
+
+		return
+	}
+
+	if err != nil {
+		for _, id := range ids {
+			batch.errs[id] = err
+		}
+
+		return
+	}
+
+	b.addResults(batch, output)
+}
+
+// batchContext is the Context for a batch's Describe call.
+// The call is shared by all callers in the batch, so it is not attributed to the first caller's resource:
+// per-resource values, such as the resource's tracing span, are removed. Logging is unchanged.
+type batchContext struct {
+	context.Context
+}
+
+func (c batchContext) Value(key any) any {
+	switch v := c.Context.Value(key); v.(type) {
+	case *conns.InContext, *tftags.InContext, *tracing.Span:
+		return nil
+	default:
+		return v
+	}
+}
+
+// isIDError returns whether a Describe call failed because of a single ID.
+func (b *describeBatcher[I, T]) isIDError(err error) bool {
+	return tfresource.NotFound(err) || tfawserr.ErrCodeEquals(err, b.idErrCodes...)
+}
+
+func (b *describeBatcher[I, T]) addResults(batch *describeBatch[T], output []T) {
+	for _, v := range output {
+		batch.results[b.id(v)] = v
+	}
+}
+
+var (
+	networkInterfaceBatcher = newDescribeBatcher(
+		func(ids []string) *ec2.DescribeNetworkInterfacesInput {
+			return &ec2.DescribeNetworkInterfacesInput{
+				NetworkInterfaceIds: ids,
+			}
+		},
+		findNetworkInterfaces,
+		func(v awstypes.NetworkInterface) string {
+			return aws.ToString(v.NetworkInterfaceId)
+		},
+		errCodeInvalidNetworkInterfaceIDMalformed,
+		errCodeInvalidNetworkInterfaceIDNotFound,
+	)
+	securityGroupBatcher = newDescribeBatcher(
+		func(ids []string) *ec2.DescribeSecurityGroupsInput {
+			return &ec2.DescribeSecurityGroupsInput{
+				GroupIds: ids,
+			}
+		},
+		findSecurityGroups,
+		func(v awstypes.SecurityGroup) string {
+			return aws.ToString(v.GroupId)
+		},
+		errCodeInvalidGroupIdMalformed,
+		errCodeInvalidGroupNotFound,
+		errCodeInvalidSecurityGroupIDNotFound,
+	)
+	subnetBatcher = newDescribeBatcher(
+		func(ids []string) *ec2.DescribeSubnetsInput {
+			return &ec2.DescribeSubnetsInput{
+				SubnetIds: ids,
+			}
+		},
+		findSubnets,
+		func(v awstypes.Subnet) string {
+			return aws.ToString(v.SubnetId)
+		},
+		errCodeInvalidSubnetIDMalformed,
+		errCodeInvalidSubnetIDNotFound,
+	)
+)
@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testDescribeInput struct {
+	ids []string
+}
+
+type testDescribeOutput struct {
+	id string
+}
+
+// testDescriber is a fake Describe operation that fails all calls that include a missing or malformed ID, like EC2.
+type testDescriber struct {
+	calls     [][]string
+	ctxs      []context.Context
+	err       error
+	existing  []string
+	malformed []string
+	mu        sync.Mutex
+}
+
+func (d *testDescriber) describe(ctx context.Context, _ *ec2.Client, input *testDescribeInput) ([]testDescribeOutput, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.calls = append(d.calls, input.ids)
+	d.ctxs = append(d.ctxs, ctx)
+
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	var output []testDescribeOutput
+	for _, id := range input.ids {
+		if slices.Contains(d.malformed, id) {
+			return nil, &smithy.GenericAPIError{Code: errCodeInvalidGroupIdMalformed}
+		}
+		if !slices.Contains(d.existing, id) {
+			return nil, &retry.NotFoundError{LastRequest: input}
+		}
+		output = append(output, testDescribeOutput{id: id})
+	}
+
+	return output, nil
+}
+
+func newTestDescribeBatcher(d *testDescriber) *describeBatcher[*testDescribeInput, testDescribeOutput] {
+	b := newDescribeBatcher(
+		func(ids []string) *testDescribeInput {
+			return &testDescribeInput{ids: ids}
+		},
+		d.describe,
+		func(v testDescribeOutput) string {
+			return v.id
+		},
+		errCodeInvalidGroupIdMalformed,
+	)
+	b.window = 50 * time.Millisecond
+
+	return b
+}
+
+func findConcurrently(ctx context.Context, b *describeBatcher[*testDescribeInput, testDescribeOutput], ids ...string) []error {
+	errs := make([]error, len(ids))
+
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		go func(i int, id string) {
+			defer wg.Done()
+
+			output, err := b.find(ctx, nil, id)
+			if err == nil && output.id != id {
+				err = errors.New("unexpected result")
+			}
+			errs[i] = err
+		}(i, id)
+	}
+	wg.Wait()
+
+	return errs
+}
+
+func TestDescribeBatcherCoalesces(t *testing.T) {
+	t.Parallel()
+
+	d := &testDescriber{existing: []string{"sg-1", "sg-2", "sg-3"}}
+	b := newTestDescribeBatcher(d)
+
+	for i, err := range findConcurrently(context.Background(), b, "sg-1", "sg-2", "sg-3", "sg-1") {
+		if err != nil {
+			t.Errorf("find %d: unexpected error: %s", i, err)
+		}
+	}
+
+	if got, want := len(d.calls), 1; got != want {
+		t.Fatalf("Describe calls: got %d, want %d", got, want)
+	}
+	if got, want := len(d.calls[0]), 3; got != want {
+		t.Errorf("Describe IDs: got %d, want %d", got, want)
+	}
+}
+
+func TestDescribeBatcherNotFound(t *testing.T) {
+	t.Parallel()
+
+	d := &testDescriber{existing: []string{"sg-1", "sg-3"}}
+	b := newTestDescribeBatcher(d)
+
+	errs := findConcurrently(context.Background(), b, "sg-1", "sg-2", "sg-3")
+
+	if errs[0] != nil {
+		t.Errorf("sg-1: unexpected error: %s", errs[0])
+	}
+	if !tfresource.NotFound(errs[1]) {
+		t.Errorf("sg-2: expected NotFound, got %v", errs[1])
+	}
+	if errs[2] != nil {
+		t.Errorf("sg-3: unexpected error: %s", errs[2])
+	}
+
+	// One batch call, then one call per ID.
+	if got, want := len(d.calls), 4; got != want {
+		t.Errorf("Describe calls: got %d, want %d", got, want)
+	}
+}
+
+func TestDescribeBatcherError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err           error
+		expectedCalls int
+	}{
+		"throttled": {
+			err: &smithy.GenericAPIError{Code: "RequestLimitExceeded"},
+			// One batch call. The error is returned to all callers.
+			expectedCalls: 1,
+		},
+		"other error": {
+			err:           errors.New("connection reset"),
+			expectedCalls: 1,
+		},
+		"ID error": {
+			err: &smithy.GenericAPIError{Code: errCodeInvalidGroupIdMalformed},
+			// One batch call, then one call per ID.
+			expectedCalls: 3,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := &testDescriber{err: testCase.err}
+			b := newTestDescribeBatcher(d)
+
+			for i, err := range findConcurrently(context.Background(), b, "sg-1", "sg-2") {
+				if !errors.Is(err, testCase.err) {
+					t.Errorf("find %d: expected %v, got %v", i, testCase.err, err)
+				}
+			}
+
+			if got, want := len(d.calls), testCase.expectedCalls; got != want {
+				t.Errorf("Describe calls: got %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestDescribeBatcherMalformed(t *testing.T) {
+	t.Parallel()
+
+	d := &testDescriber{existing: []string{"sg-1", "sg-3"}, malformed: []string{"sg-x"}}
+	b := newTestDescribeBatcher(d)
+
+	errs := findConcurrently(context.Background(), b, "sg-1", "sg-x", "sg-3")
+
+	if errs[0] != nil {
+		t.Errorf("sg-1: unexpected error: %s", errs[0])
+	}
+	if errs[1] == nil || tfresource.NotFound(errs[1]) {
+		t.Errorf("sg-x: expected error, got %v", errs[1])
+	}
+	if errs[2] != nil {
+		t.Errorf("sg-3: unexpected error: %s", errs[2])
+	}
+
+	// One batch call, then one call per ID.
+	if got, want := len(d.calls), 4; got != want {
+		t.Errorf("Describe calls: got %d, want %d", got, want)
+	}
+}
+
+func TestDescribeBatcherContext(t *testing.T) {
+	t.Parallel()
+
+	d := &testDescriber{existing: []string{"sg-1"}}
+	b := newTestDescribeBatcher(d)
+
+	ctx := conns.NewResourceContext(context.Background(), "ec2", "Security Group", "aws_security_group")
+
+	if _, err := b.find(ctx, nil, "sg-1"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(d.ctxs), 1; got != want {
+		t.Fatalf("Describe calls: got %d, want %d", got, want)
+	}
+	if _, ok := conns.FromContext(d.ctxs[0]); ok {
+		t.Error("unexpected caller's resource in batch Context")
+	}
+}
+
+func TestDescribeBatcherCanceled(t *testing.T) {
+	t.Parallel()
+
+	d := &testDescriber{existing: []string{"sg-1"}}
+	b := newTestDescribeBatcher(d)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := b.find(ctx, nil, "sg-1"); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+
+	// Other callers in the same batch are not affected.
+	if _, err := b.find(context.Background(), nil, "sg-1"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
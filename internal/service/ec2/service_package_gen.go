@@ -460,6 +460,9 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 		{
 			Factory:  dataSourceSubnet,
 			TypeName: "aws_subnet",
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
 		},
 		{
 			Factory:  dataSourceSubnets,
@@ -469,7 +472,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceVPC,
 			TypeName: "aws_vpc",
 			Name:     "VPC",
-			Tags:     &types.ServicePackageResourceTags{},
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
+			Tags: &types.ServicePackageResourceTags{},
 		},
 		{
 			Factory:  dataSourceVPCDHCPOptions,
@@ -1084,6 +1090,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceSecurityGroup,
 			TypeName: "aws_security_group",
 			Name:     "Security Group",
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
@@ -1123,6 +1132,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceSubnet,
 			TypeName: "aws_subnet",
 			Name:     "Subnet",
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
@@ -1178,6 +1190,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceVPC,
 			TypeName: "aws_vpc",
 			Name:     "VPC",
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
@@ -50,6 +50,7 @@ var (
 )
 
 // @SDKResource("aws_vpc", name="VPC")
+// @Region(overrideEnabled=true)
 // @Tags(identifierAttribute="id")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.Vpc")
 func resourceVPC() *schema.Resource {
@@ -289,7 +290,7 @@ func resourceVPCRead(ctx context.Context, d *schema.ResourceData, meta interface
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   names.EC2,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).EffectiveRegion(ctx),
 		AccountID: ownerID,
 		Resource:  fmt.Sprintf("vpc/%s", d.Id()),
 	}.String()
@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKDataSource("aws_vpc", name="VPC")
+// @Region(overrideEnabled=true)
 // @Tags
 func dataSourceVPC() *schema.Resource {
 	return &schema.Resource{
@@ -167,7 +168,7 @@ func dataSourceVPCRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   names.EC2,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).EffectiveRegion(ctx),
 		AccountID: aws.ToString(ownerID),
 		Resource:  "vpc/" + d.Id(),
 	}.String()
@@ -35,6 +35,7 @@ import (
 )
 
 // @SDKResource("aws_security_group", name="Security Group")
+// @Region(overrideEnabled=true)
 // @Tags(identifierAttribute="id")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;types.SecurityGroup")
 // @Testing(importIgnore="revoke_rules_on_delete")
@@ -299,7 +300,7 @@ func resourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   names.EC2,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).EffectiveRegion(ctx),
 		AccountID: ownerID,
 		Resource:  fmt.Sprintf("security-group/%s", d.Id()),
 	}
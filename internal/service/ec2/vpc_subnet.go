@@ -28,6 +28,7 @@ import (
 )
 
 // @SDKResource("aws_subnet", name="Subnet")
+// @Region(overrideEnabled=true)
 // @Tags(identifierAttribute="id")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;types.Subnet")
 func resourceSubnet() *schema.Resource {
@@ -20,6 +20,7 @@ import (
 )
 
 // @SDKDataSource("aws_subnet")
+// @Region(overrideEnabled=true)
 func dataSourceSubnet() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceSubnetRead,
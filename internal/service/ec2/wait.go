@@ -72,7 +72,7 @@ func waitAvailabilityZoneGroupNotOptedIn(ctx context.Context, conn *ec2.Client,
 		Timeout: availabilityZoneGroupOptInStatusTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.AvailabilityZone); ok {
 		return output, err
@@ -89,7 +89,7 @@ func waitAvailabilityZoneGroupOptedIn(ctx context.Context, conn *ec2.Client, nam
 		Timeout: availabilityZoneGroupOptInStatusTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.AvailabilityZone); ok {
 		return output, err
@@ -106,7 +106,7 @@ func waitCapacityReservationActive(ctx context.Context, conn *ec2.Client, id str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.CapacityReservation); ok {
 		return output, err
@@ -123,7 +123,7 @@ func waitCapacityReservationDeleted(ctx context.Context, conn *ec2.Client, id st
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.CapacityReservation); ok {
 		return output, err
@@ -142,7 +142,7 @@ func waitCapacityBlockReservationActive(ctx context.Context, conn *ec2.Client, i
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.CapacityReservation); ok {
 		return output, err
@@ -159,7 +159,7 @@ func waitCarrierGatewayCreated(ctx context.Context, conn *ec2.Client, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.CarrierGateway); ok {
 		return output, err
@@ -176,7 +176,7 @@ func waitCarrierGatewayDeleted(ctx context.Context, conn *ec2.Client, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.CarrierGateway); ok {
 		return output, err
@@ -193,7 +193,7 @@ func waitClientVPNAuthorizationRuleCreated(ctx context.Context, conn *ec2.Client
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.AuthorizationRule); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.Status.Message)))
@@ -212,7 +212,7 @@ func waitClientVPNAuthorizationRuleDeleted(ctx context.Context, conn *ec2.Client
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.AuthorizationRule); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.Status.Message)))
@@ -231,7 +231,7 @@ func waitClientVPNEndpointClientConnectResponseOptionsUpdated(ctx context.Contex
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ClientConnectResponseOptions); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.Status.Message)))
@@ -250,7 +250,7 @@ func waitClientVPNEndpointDeleted(ctx context.Context, conn *ec2.Client, id stri
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ClientVpnEndpoint); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.Status.Message)))
@@ -271,7 +271,7 @@ func waitClientVPNNetworkAssociationCreated(ctx context.Context, conn *ec2.Clien
 		PollInterval: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TargetNetwork); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.Status.Message)))
@@ -292,7 +292,7 @@ func waitClientVPNNetworkAssociationDeleted(ctx context.Context, conn *ec2.Clien
 		PollInterval: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TargetNetwork); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.Status.Message)))
@@ -311,7 +311,7 @@ func waitClientVPNRouteCreated(ctx context.Context, conn *ec2.Client, endpointID
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ClientVpnRoute); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.Status.Message)))
@@ -330,7 +330,7 @@ func waitClientVPNRouteDeleted(ctx context.Context, conn *ec2.Client, endpointID
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ClientVpnRoute); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.Status.Message)))
@@ -354,7 +354,7 @@ func waitCustomerGatewayCreated(ctx context.Context, conn *ec2.Client, id string
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.CustomerGateway); ok {
 		return output, err
@@ -374,7 +374,7 @@ func waitCustomerGatewayDeleted(ctx context.Context, conn *ec2.Client, id string
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.CustomerGateway); ok {
 		return output, err
@@ -398,7 +398,7 @@ func waitEBSSnapshotImportComplete(ctx context.Context, conn *ec2.Client, import
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SnapshotTaskDetail); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
@@ -418,7 +418,7 @@ func waitEBSSnapshotTierArchive(ctx context.Context, conn *ec2.Client, id string
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SnapshotTierStatus); ok {
 		tfresource.SetLastError(err, fmt.Errorf("%s: %s", string(output.LastTieringOperationStatus), aws.ToString(output.LastTieringOperationStatusDetail)))
@@ -437,7 +437,7 @@ func waitEIPDomainNameAttributeDeleted(ctx context.Context, conn *ec2.Client, al
 		Refresh: statusEIPDomainNameAttribute(ctx, conn, allocationID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.AddressAttribute); ok {
 		if v := output.PtrRecordUpdate; v != nil {
@@ -458,7 +458,7 @@ func waitEIPDomainNameAttributeUpdated(ctx context.Context, conn *ec2.Client, al
 		Refresh: statusEIPDomainNameAttribute(ctx, conn, allocationID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.AddressAttribute); ok {
 		if v := output.PtrRecordUpdate; v != nil {
@@ -479,7 +479,7 @@ func waitFastSnapshotRestoreCreated(ctx context.Context, conn *ec2.Client, avail
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DescribeFastSnapshotRestoreSuccessItem); ok {
 		return output, err
@@ -496,7 +496,7 @@ func waitFastSnapshotRestoreDeleted(ctx context.Context, conn *ec2.Client, avail
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DescribeFastSnapshotRestoreSuccessItem); ok {
 		return output, err
@@ -515,7 +515,7 @@ func waitFleet(ctx context.Context, conn *ec2.Client, id string, pending, target
 		MinTimeout: 1 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -528,7 +528,7 @@ func waitHostCreated(ctx context.Context, conn *ec2.Client, id string, timeout t
 		Refresh: statusHost(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Host); ok {
 		return output, err
@@ -545,7 +545,7 @@ func waitHostDeleted(ctx context.Context, conn *ec2.Client, id string, timeout t
 		Refresh: statusHost(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Host); ok {
 		return output, err
@@ -562,7 +562,7 @@ func waitHostUpdated(ctx context.Context, conn *ec2.Client, id string, timeout t
 		Refresh: statusHost(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Host); ok {
 		return output, err
@@ -581,7 +581,7 @@ func waitImageAvailable(ctx context.Context, conn *ec2.Client, id string, timeou
 		MinTimeout: amiRetryMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Image); ok {
 		if stateReason := output.StateReason; stateReason != nil {
@@ -603,7 +603,7 @@ func waitImageBlockPublicAccessState(ctx context.Context, conn *ec2.Client, targ
 		MinTimeout: 10 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -618,7 +618,7 @@ func waitImageDeleted(ctx context.Context, conn *ec2.Client, id string, timeout
 		MinTimeout: amiRetryMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Image); ok {
 		if stateReason := output.StateReason; stateReason != nil {
@@ -640,7 +640,7 @@ func waitInstanceCapacityReservationSpecificationUpdated(ctx context.Context, co
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Instance); ok {
 		return output, err
@@ -657,7 +657,7 @@ func waitInstanceConnectEndpointCreated(ctx context.Context, conn *ec2.Client, i
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Ec2InstanceConnectEndpoint); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateMessage)))
@@ -676,7 +676,7 @@ func waitInstanceConnectEndpointDeleted(ctx context.Context, conn *ec2.Client, i
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Ec2InstanceConnectEndpoint); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateMessage)))
@@ -696,7 +696,7 @@ func waitInstanceIAMInstanceProfileUpdated(ctx context.Context, conn *ec2.Client
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Instance); ok {
 		return output, err
@@ -714,7 +714,7 @@ func waitInstanceMaintenanceOptionsAutoRecoveryUpdated(ctx context.Context, conn
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.InstanceMaintenanceOptions); ok {
 		return output, err
@@ -733,7 +733,7 @@ func waitInstanceMetadataOptionsApplied(ctx context.Context, conn *ec2.Client, i
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.InstanceMetadataOptionsResponse); ok {
 		return output, err
@@ -751,7 +751,7 @@ func waitInstanceRootBlockDeviceDeleteOnTerminationUpdated(ctx context.Context,
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.EbsInstanceBlockDevice); ok {
 		return output, err
@@ -769,7 +769,7 @@ func waitInternetGatewayAttached(ctx context.Context, conn *ec2.Client, internet
 		Refresh:        statusInternetGatewayAttachmentState(ctx, conn, internetGatewayID, vpcID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.InternetGatewayAttachment); ok {
 		return output, err
@@ -786,7 +786,7 @@ func waitInternetGatewayDetached(ctx context.Context, conn *ec2.Client, internet
 		Refresh: statusInternetGatewayAttachmentState(ctx, conn, internetGatewayID, vpcID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.InternetGatewayAttachment); ok {
 		return output, err
@@ -804,7 +804,7 @@ func waitIPAMCreated(ctx context.Context, conn *ec2.Client, id string, timeout t
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Ipam); ok {
 		return output, err
@@ -822,7 +822,7 @@ func waitIPAMDeleted(ctx context.Context, conn *ec2.Client, id string, timeout t
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Ipam); ok {
 		return output, err
@@ -841,7 +841,7 @@ func waitIPAMPoolCIDRCreated(ctx context.Context, conn *ec2.Client, poolCIDRID,
 		NotFoundChecks: 1000, // Should exceed any reasonable custom timeout value.
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.IpamPoolCidr); ok {
 		if state, failureReason := output.State, output.FailureReason; state == awstypes.IpamPoolCidrStateFailedProvision && failureReason != nil {
@@ -863,7 +863,7 @@ func waitIPAMPoolCIDRDeleted(ctx context.Context, conn *ec2.Client, poolCIDRID,
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.IpamPoolCidr); ok {
 		if state, failureReason := output.State, output.FailureReason; state == awstypes.IpamPoolCidrStateFailedDeprovision && failureReason != nil {
@@ -885,7 +885,7 @@ func waitIPAMPoolCreated(ctx context.Context, conn *ec2.Client, id string, timeo
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.IpamPool); ok {
 		if state := output.State; state == awstypes.IpamPoolStateCreateFailed {
@@ -907,7 +907,7 @@ func waitIPAMPoolDeleted(ctx context.Context, conn *ec2.Client, id string, timeo
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.IpamPool); ok {
 		if state := output.State; state == awstypes.IpamPoolStateDeleteFailed {
@@ -929,7 +929,7 @@ func waitIPAMPoolUpdated(ctx context.Context, conn *ec2.Client, id string, timeo
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.IpamPool); ok {
 		if state := output.State; state == awstypes.IpamPoolStateModifyFailed {
@@ -951,7 +951,7 @@ func waitIPAMResourceDiscoveryAssociationCreated(ctx context.Context, conn *ec2.
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.IpamResourceDiscoveryAssociation); ok {
 		return output, err
@@ -969,7 +969,7 @@ func waitIPAMResourceDiscoveryAssociationDeleted(ctx context.Context, conn *ec2.
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.IpamResourceDiscoveryAssociation); ok {
 		return output, err
@@ -987,7 +987,7 @@ func waitIPAMResourceDiscoveryCreated(ctx context.Context, conn *ec2.Client, id
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.IpamResourceDiscovery); ok {
 		return output, err
@@ -1005,7 +1005,7 @@ func waitIPAMResourceDiscoveryDeleted(ctx context.Context, conn *ec2.Client, id
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.IpamResourceDiscovery); ok {
 		return output, err
@@ -1023,7 +1023,7 @@ func waitIPAMResourceDiscoveryUpdated(ctx context.Context, conn *ec2.Client, id
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.IpamResourceDiscovery); ok {
 		return output, err
@@ -1041,7 +1041,7 @@ func waitIPAMScopeCreated(ctx context.Context, conn *ec2.Client, id string, time
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.IpamScope); ok {
 		return output, err
@@ -1059,7 +1059,7 @@ func waitIPAMScopeDeleted(ctx context.Context, conn *ec2.Client, id string, time
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.IpamScope); ok {
 		return output, err
@@ -1077,7 +1077,7 @@ func waitIPAMScopeUpdated(ctx context.Context, conn *ec2.Client, id string, time
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.IpamScope); ok {
 		return output, err
@@ -1095,7 +1095,7 @@ func waitIPAMUpdated(ctx context.Context, conn *ec2.Client, id string, timeout t
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Ipam); ok {
 		return output, err
@@ -1115,7 +1115,7 @@ func waitLocalGatewayRouteDeleted(ctx context.Context, conn *ec2.Client, localGa
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.LocalGatewayRoute); ok {
 		return output, err
@@ -1135,7 +1135,7 @@ func waitLocalGatewayRouteTableVPCAssociationAssociated(ctx context.Context, con
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.LocalGatewayRouteTableVpcAssociation); ok {
 		return output, err
@@ -1155,7 +1155,7 @@ func waitLocalGatewayRouteTableVPCAssociationDisassociated(ctx context.Context,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.LocalGatewayRouteTableVpcAssociation); ok {
 		return output, err
@@ -1172,7 +1172,7 @@ func waitManagedPrefixListCreated(ctx context.Context, conn *ec2.Client, id stri
 		Refresh: statusManagedPrefixListState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ManagedPrefixList); ok {
 		if output.State == awstypes.PrefixListStateCreateFailed {
@@ -1193,7 +1193,7 @@ func waitManagedPrefixListDeleted(ctx context.Context, conn *ec2.Client, id stri
 		Refresh: statusManagedPrefixListState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ManagedPrefixList); ok {
 		if output.State == awstypes.PrefixListStateDeleteFailed {
@@ -1214,7 +1214,7 @@ func waitManagedPrefixListModified(ctx context.Context, conn *ec2.Client, id str
 		Refresh: statusManagedPrefixListState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ManagedPrefixList); ok {
 		if output.State == awstypes.PrefixListStateModifyFailed {
@@ -1235,7 +1235,7 @@ func waitNATGatewayAddressAssigned(ctx context.Context, conn *ec2.Client, natGat
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.NatGatewayAddress); ok {
 		if output.Status == awstypes.NatGatewayAddressStatusFailed {
@@ -1256,7 +1256,7 @@ func waitNATGatewayAddressAssociated(ctx context.Context, conn *ec2.Client, natG
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.NatGatewayAddress); ok {
 		if output.Status == awstypes.NatGatewayAddressStatusFailed {
@@ -1277,7 +1277,7 @@ func waitNATGatewayAddressDisassociated(ctx context.Context, conn *ec2.Client, n
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.NatGatewayAddress); ok {
 		if output.Status == awstypes.NatGatewayAddressStatusFailed {
@@ -1298,7 +1298,7 @@ func waitNATGatewayAddressUnassigned(ctx context.Context, conn *ec2.Client, natG
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.NatGatewayAddress); ok {
 		if output.Status == awstypes.NatGatewayAddressStatusFailed {
@@ -1319,7 +1319,7 @@ func waitNATGatewayCreated(ctx context.Context, conn *ec2.Client, id string, tim
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.NatGateway); ok {
 		if output.State == awstypes.NatGatewayStateFailed {
@@ -1342,7 +1342,7 @@ func waitNATGatewayDeleted(ctx context.Context, conn *ec2.Client, id string, tim
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.NatGateway); ok {
 		if output.State == awstypes.NatGatewayStateFailed {
@@ -1365,7 +1365,7 @@ func waitNetworkInsightsAnalysisCreated(ctx context.Context, conn *ec2.Client, i
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.NetworkInsightsAnalysis); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
@@ -1391,7 +1391,7 @@ func waitNetworkInterfaceAvailableAfterUse(ctx context.Context, conn *ec2.Client
 		NotFoundChecks:            1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.NetworkInterface); ok {
 		return output, err
@@ -1408,7 +1408,7 @@ func waitNetworkInterfaceCreated(ctx context.Context, conn *ec2.Client, id strin
 		Refresh: statusNetworkInterface(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.NetworkInterface); ok {
 		return output, err
@@ -1425,7 +1425,7 @@ func waitNetworkInterfaceAttached(ctx context.Context, conn *ec2.Client, id stri
 		Refresh: statusNetworkInterfaceAttachment(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.NetworkInterfaceAttachment); ok {
 		return output, err
@@ -1442,7 +1442,7 @@ func waitNetworkInterfaceDetached(ctx context.Context, conn *ec2.Client, id stri
 		Refresh: statusNetworkInterfaceAttachment(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.NetworkInterfaceAttachment); ok {
 		return output, err
@@ -1459,7 +1459,7 @@ func waitPlacementGroupCreated(ctx context.Context, conn *ec2.Client, name strin
 		Refresh: statusPlacementGroup(ctx, conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.PlacementGroup); ok {
 		return output, err
@@ -1476,7 +1476,7 @@ func waitPlacementGroupDeleted(ctx context.Context, conn *ec2.Client, name strin
 		Refresh: statusPlacementGroup(ctx, conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.PlacementGroup); ok {
 		return output, err
@@ -1494,7 +1494,7 @@ func waitRouteDeleted(ctx context.Context, conn *ec2.Client, routeFinder routeFi
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Route); ok {
 		return output, err
@@ -1513,7 +1513,7 @@ func waitRouteReady(ctx context.Context, conn *ec2.Client, routeFinder routeFind
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Route); ok {
 		return output, err
@@ -1531,7 +1531,7 @@ func waitRouteTableAssociationCreated(ctx context.Context, conn *ec2.Client, id
 		NotFoundChecks: routeTableAssociationCreatedNotFoundChecks,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.RouteTableAssociationState); ok {
 		if output.State == awstypes.RouteTableAssociationStateCodeFailed {
@@ -1552,7 +1552,7 @@ func waitRouteTableAssociationDeleted(ctx context.Context, conn *ec2.Client, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.RouteTableAssociationState); ok {
 		if output.State == awstypes.RouteTableAssociationStateCodeFailed {
@@ -1573,7 +1573,7 @@ func waitRouteTableAssociationUpdated(ctx context.Context, conn *ec2.Client, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.RouteTableAssociationState); ok {
 		if output.State == awstypes.RouteTableAssociationStateCodeFailed {
@@ -1595,7 +1595,7 @@ func waitRouteTableDeleted(ctx context.Context, conn *ec2.Client, id string, tim
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.RouteTable); ok {
 		return output, err
@@ -1614,7 +1614,7 @@ func waitRouteTableReady(ctx context.Context, conn *ec2.Client, id string, timeo
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.RouteTable); ok {
 		return output, err
@@ -1633,7 +1633,7 @@ func waitSecurityGroupCreated(ctx context.Context, conn *ec2.Client, id string,
 		ContinuousTargetOccurence: 3,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SecurityGroup); ok {
 		return output, err
@@ -1652,7 +1652,7 @@ func waitSpotFleetRequestCreated(ctx context.Context, conn *ec2.Client, id strin
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SpotFleetRequestConfig); ok {
 		return output, err
@@ -1671,7 +1671,7 @@ func waitSpotFleetRequestFulfilled(ctx context.Context, conn *ec2.Client, id str
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SpotFleetRequestConfig); ok {
 		if output.ActivityStatus == awstypes.ActivityStatusError {
@@ -1709,7 +1709,7 @@ func waitSpotFleetRequestUpdated(ctx context.Context, conn *ec2.Client, id strin
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SpotFleetRequestConfig); ok {
 		return output, err
@@ -1728,7 +1728,7 @@ func waitSpotInstanceRequestFulfilled(ctx context.Context, conn *ec2.Client, id
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SpotInstanceRequest); ok {
 		if fault := output.Fault; fault != nil {
@@ -1753,7 +1753,7 @@ func waitSubnetAssignIPv6AddressOnCreationUpdated(ctx context.Context, conn *ec2
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Subnet); ok {
 		return output, err
@@ -1770,7 +1770,7 @@ func waitSubnetAvailable(ctx context.Context, conn *ec2.Client, id string, timeo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Subnet); ok {
 		return output, err
@@ -1788,7 +1788,7 @@ func waitSubnetEnableDNS64Updated(ctx context.Context, conn *ec2.Client, subnetI
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Subnet); ok {
 		return output, err
@@ -1806,7 +1806,7 @@ func waitSubnetEnableLniAtDeviceIndexUpdated(ctx context.Context, conn *ec2.Clie
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Subnet); ok {
 		return output, err
@@ -1824,7 +1824,7 @@ func waitSubnetEnableResourceNameDNSAAAARecordOnLaunchUpdated(ctx context.Contex
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Subnet); ok {
 		return output, err
@@ -1842,7 +1842,7 @@ func waitSubnetEnableResourceNameDNSARecordOnLaunchUpdated(ctx context.Context,
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Subnet); ok {
 		return output, err
@@ -1859,7 +1859,7 @@ func waitSubnetIPv6CIDRBlockAssociationCreated(ctx context.Context, conn *ec2.Cl
 		Timeout: subnetIPv6CIDRBlockAssociationCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SubnetCidrBlockState); ok {
 		if output.State == awstypes.SubnetCidrBlockStateCodeFailed {
@@ -1880,7 +1880,7 @@ func waitSubnetIPv6CIDRBlockAssociationDeleted(ctx context.Context, conn *ec2.Cl
 		Timeout: subnetIPv6CIDRBlockAssociationDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SubnetCidrBlockState); ok {
 		if output.State == awstypes.SubnetCidrBlockStateCodeFailed {
@@ -1902,7 +1902,7 @@ func waitSubnetMapCustomerOwnedIPOnLaunchUpdated(ctx context.Context, conn *ec2.
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Subnet); ok {
 		return output, err
@@ -1920,7 +1920,7 @@ func waitSubnetMapPublicIPOnLaunchUpdated(ctx context.Context, conn *ec2.Client,
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Subnet); ok {
 		return output, err
@@ -1938,7 +1938,7 @@ func waitSubnetPrivateDNSHostnameTypeOnLaunchUpdated(ctx context.Context, conn *
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Subnet); ok {
 		return output, err
@@ -1955,7 +1955,7 @@ func waitTransitGatewayConnectCreated(ctx context.Context, conn *ec2.Client, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayConnect); ok {
 		return output, err
@@ -1973,7 +1973,7 @@ func waitTransitGatewayConnectDeleted(ctx context.Context, conn *ec2.Client, id
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayConnect); ok {
 		return output, err
@@ -1990,7 +1990,7 @@ func waitTransitGatewayConnectPeerCreated(ctx context.Context, conn *ec2.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayConnectPeer); ok {
 		return output, err
@@ -2007,7 +2007,7 @@ func waitTransitGatewayConnectPeerDeleted(ctx context.Context, conn *ec2.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayConnectPeer); ok {
 		return output, err
@@ -2024,7 +2024,7 @@ func waitTransitGatewayCreated(ctx context.Context, conn *ec2.Client, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGateway); ok {
 		return output, err
@@ -2042,7 +2042,7 @@ func waitTransitGatewayDeleted(ctx context.Context, conn *ec2.Client, id string,
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGateway); ok {
 		return output, err
@@ -2059,7 +2059,7 @@ func waitTransitGatewayMulticastDomainCreated(ctx context.Context, conn *ec2.Cli
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayMulticastDomain); ok {
 		return output, err
@@ -2076,7 +2076,7 @@ func waitTransitGatewayMulticastDomainDeleted(ctx context.Context, conn *ec2.Cli
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayMulticastDomain); ok {
 		return output, err
@@ -2093,7 +2093,7 @@ func waitTransitGatewayMulticastDomainAssociationCreated(ctx context.Context, co
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayMulticastDomainAssociation); ok {
 		return output, err
@@ -2110,7 +2110,7 @@ func waitTransitGatewayMulticastDomainAssociationDeleted(ctx context.Context, co
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayMulticastDomainAssociation); ok {
 		return output, err
@@ -2127,7 +2127,7 @@ func waitTransitGatewayPeeringAttachmentAccepted(ctx context.Context, conn *ec2.
 		Refresh: statusTransitGatewayPeeringAttachment(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayPeeringAttachment); ok {
 		if status := output.Status; status != nil {
@@ -2148,7 +2148,7 @@ func waitTransitGatewayPeeringAttachmentCreated(ctx context.Context, conn *ec2.C
 		Refresh: statusTransitGatewayPeeringAttachment(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayPeeringAttachment); ok {
 		if status := output.Status; status != nil {
@@ -2174,7 +2174,7 @@ func waitTransitGatewayPeeringAttachmentDeleted(ctx context.Context, conn *ec2.C
 		Refresh: statusTransitGatewayPeeringAttachment(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayPeeringAttachment); ok {
 		if status := output.Status; status != nil {
@@ -2193,7 +2193,7 @@ func waitTransitGatewayPrefixListReferenceStateCreated(ctx context.Context, conn
 		Refresh: statusTransitGatewayPrefixListReference(ctx, conn, transitGatewayRouteTableID, prefixListID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayPrefixListReference); ok {
 		return output, err
@@ -2210,7 +2210,7 @@ func waitTransitGatewayPrefixListReferenceStateDeleted(ctx context.Context, conn
 		Refresh: statusTransitGatewayPrefixListReference(ctx, conn, transitGatewayRouteTableID, prefixListID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayPrefixListReference); ok {
 		return output, err
@@ -2227,7 +2227,7 @@ func waitTransitGatewayPrefixListReferenceStateUpdated(ctx context.Context, conn
 		Refresh: statusTransitGatewayPrefixListReference(ctx, conn, transitGatewayRouteTableID, prefixListID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayPrefixListReference); ok {
 		return output, err
@@ -2244,7 +2244,7 @@ func waitTransitGatewayRouteCreated(ctx context.Context, conn *ec2.Client, trans
 		Refresh: statusTransitGatewayStaticRoute(ctx, conn, transitGatewayRouteTableID, destination),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayRoute); ok {
 		return output, err
@@ -2261,7 +2261,7 @@ func waitTransitGatewayRouteDeleted(ctx context.Context, conn *ec2.Client, trans
 		Refresh: statusTransitGatewayStaticRoute(ctx, conn, transitGatewayRouteTableID, destination),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayRoute); ok {
 		return output, err
@@ -2278,7 +2278,7 @@ func waitTransitGatewayPolicyTableCreated(ctx context.Context, conn *ec2.Client,
 		Refresh: statusTransitGatewayPolicyTable(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayPolicyTable); ok {
 		return output, err
@@ -2295,7 +2295,7 @@ func waitTransitGatewayRouteTableCreated(ctx context.Context, conn *ec2.Client,
 		Refresh: statusTransitGatewayRouteTable(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayRouteTable); ok {
 		return output, err
@@ -2312,7 +2312,7 @@ func waitTransitGatewayPolicyTableDeleted(ctx context.Context, conn *ec2.Client,
 		Refresh: statusTransitGatewayPolicyTable(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayPolicyTable); ok {
 		return output, err
@@ -2329,7 +2329,7 @@ func waitTransitGatewayRouteTableDeleted(ctx context.Context, conn *ec2.Client,
 		Refresh: statusTransitGatewayRouteTable(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayRouteTable); ok {
 		return output, err
@@ -2346,7 +2346,7 @@ func waitTransitGatewayPolicyTableAssociationCreated(ctx context.Context, conn *
 		Refresh: statusTransitGatewayPolicyTableAssociation(ctx, conn, transitGatewayPolicyTableID, transitGatewayAttachmentID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayPolicyTableAssociation); ok {
 		return output, err
@@ -2364,7 +2364,7 @@ func waitTransitGatewayPolicyTableAssociationDeleted(ctx context.Context, conn *
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayPolicyTableAssociation); ok {
 		return output, err
@@ -2381,7 +2381,7 @@ func waitTransitGatewayRouteTableAssociationCreated(ctx context.Context, conn *e
 		Refresh: statusTransitGatewayRouteTableAssociation(ctx, conn, transitGatewayRouteTableID, transitGatewayAttachmentID),
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -2395,7 +2395,7 @@ func waitTransitGatewayRouteTableAssociationDeleted(ctx context.Context, conn *e
 		NotFoundChecks: 1,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -2408,7 +2408,7 @@ func waitTransitGatewayRouteTablePropagationCreated(ctx context.Context, conn *e
 		Refresh: statusTransitGatewayRouteTablePropagation(ctx, conn, transitGatewayRouteTableID, transitGatewayAttachmentID),
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -2421,7 +2421,7 @@ func waitTransitGatewayRouteTablePropagationDeleted(ctx context.Context, conn *e
 		Refresh: statusTransitGatewayRouteTablePropagation(ctx, conn, transitGatewayRouteTableID, transitGatewayAttachmentID),
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteTableIDNotFound) {
 		return nil
@@ -2438,7 +2438,7 @@ func waitTransitGatewayUpdated(ctx context.Context, conn *ec2.Client, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGateway); ok {
 		return output, err
@@ -2455,7 +2455,7 @@ func waitTransitGatewayVPCAttachmentAccepted(ctx context.Context, conn *ec2.Clie
 		Refresh: statusTransitGatewayVPCAttachment(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayVpcAttachment); ok {
 		return output, err
@@ -2472,7 +2472,7 @@ func waitTransitGatewayVPCAttachmentCreated(ctx context.Context, conn *ec2.Clien
 		Refresh: statusTransitGatewayVPCAttachment(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayVpcAttachment); ok {
 		return output, err
@@ -2494,7 +2494,7 @@ func waitTransitGatewayVPCAttachmentDeleted(ctx context.Context, conn *ec2.Clien
 		Refresh: statusTransitGatewayVPCAttachment(ctx, conn, id),
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -2507,7 +2507,7 @@ func waitTransitGatewayVPCAttachmentUpdated(ctx context.Context, conn *ec2.Clien
 		Refresh: statusTransitGatewayVPCAttachment(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TransitGatewayVpcAttachment); ok {
 		return output, err
@@ -2526,7 +2526,7 @@ func waitVerifiedAccessEndpointCreated(ctx context.Context, conn *ec2.Client, id
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VerifiedAccessEndpoint); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.Status.Message)))
@@ -2545,7 +2545,7 @@ func waitVerifiedAccessEndpointDeleted(ctx context.Context, conn *ec2.Client, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VerifiedAccessEndpoint); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.Status.Message)))
@@ -2566,7 +2566,7 @@ func waitVerifiedAccessEndpointUpdated(ctx context.Context, conn *ec2.Client, id
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VerifiedAccessEndpoint); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.Status.Message)))
@@ -2587,7 +2587,7 @@ func waitVolumeAttachmentCreated(ctx context.Context, conn *ec2.Client, volumeID
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VolumeAttachment); ok {
 		return output, err
@@ -2606,7 +2606,7 @@ func waitVolumeCreated(ctx context.Context, conn *ec2.Client, id string, timeout
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Volume); ok {
 		return output, err
@@ -2625,7 +2625,7 @@ func waitVolumeDeleted(ctx context.Context, conn *ec2.Client, id string, timeout
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Volume); ok {
 		return output, err
@@ -2647,7 +2647,7 @@ func waitVolumeModificationComplete(ctx context.Context, conn *ec2.Client, id st
 		MinTimeout: 30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VolumeModification); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
@@ -2668,7 +2668,7 @@ func waitVolumeUpdated(ctx context.Context, conn *ec2.Client, id string, timeout
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Volume); ok {
 		return output, err
@@ -2686,7 +2686,7 @@ func waitVPCAttributeUpdated(ctx context.Context, conn *ec2.Client, vpcID string
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Vpc); ok {
 		return output, err
@@ -2705,7 +2705,7 @@ func waitVPCCIDRBlockAssociationCreated(ctx context.Context, conn *ec2.Client, i
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VpcCidrBlockState); ok {
 		if state := output.State; state == awstypes.VpcCidrBlockStateCodeFailed {
@@ -2728,7 +2728,7 @@ func waitVPCCIDRBlockAssociationDeleted(ctx context.Context, conn *ec2.Client, i
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VpcCidrBlockState); ok {
 		if state := output.State; state == awstypes.VpcCidrBlockStateCodeFailed {
@@ -2749,7 +2749,7 @@ func waitVPCCreated(ctx context.Context, conn *ec2.Client, id string) (*awstypes
 		Timeout: vpcCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Vpc); ok {
 		return output, err
@@ -2768,7 +2768,7 @@ func waitVPCEndpointAccepted(ctx context.Context, conn *ec2.Client, vpcEndpointI
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VpcEndpoint); ok {
 		if state, lastError := output.State, output.LastError; state == awstypes.StateFailed && lastError != nil {
@@ -2791,7 +2791,7 @@ func waitVPCEndpointAvailable(ctx context.Context, conn *ec2.Client, vpcEndpoint
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VpcEndpoint); ok {
 		if state, lastError := output.State, output.LastError; state == awstypes.StateFailed && lastError != nil {
@@ -2814,7 +2814,7 @@ func waitVPCEndpointConnectionAccepted(ctx context.Context, conn *ec2.Client, se
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VpcEndpointConnection); ok {
 		return output, err
@@ -2833,7 +2833,7 @@ func waitVPCEndpointDeleted(ctx context.Context, conn *ec2.Client, vpcEndpointID
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VpcEndpoint); ok {
 		return output, err
@@ -2851,7 +2851,7 @@ func waitVPCEndpointRouteTableAssociationDeleted(ctx context.Context, conn *ec2.
 		ContinuousTargetOccurence: 2,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -2865,7 +2865,7 @@ func waitVPCEndpointRouteTableAssociationReady(ctx context.Context, conn *ec2.Cl
 		ContinuousTargetOccurence: 2,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -2880,7 +2880,7 @@ func waitVPCEndpointServiceAvailable(ctx context.Context, conn *ec2.Client, id s
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ServiceConfiguration); ok {
 		return output, err
@@ -2899,7 +2899,7 @@ func waitVPCEndpointServiceDeleted(ctx context.Context, conn *ec2.Client, id str
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ServiceConfiguration); ok {
 		return output, err
@@ -2917,7 +2917,7 @@ func waitVPCEndpointServicePrivateDNSNameVerified(ctx context.Context, conn *ec2
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.PrivateDnsNameConfiguration); ok {
 		return out, err
 	}
@@ -2935,7 +2935,7 @@ func waitVPCIPv6CIDRBlockAssociationCreated(ctx context.Context, conn *ec2.Clien
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VpcCidrBlockState); ok {
 		if state := output.State; state == awstypes.VpcCidrBlockStateCodeFailed {
@@ -2958,7 +2958,7 @@ func waitVPCIPv6CIDRBlockAssociationDeleted(ctx context.Context, conn *ec2.Clien
 		MinTimeout: 5 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -2972,7 +2972,7 @@ func waitVPCPeeringConnectionActive(ctx context.Context, conn *ec2.Client, id st
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VpcPeeringConnection); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.Status.Message)))
@@ -2995,7 +2995,7 @@ func waitVPCPeeringConnectionDeleted(ctx context.Context, conn *ec2.Client, id s
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VpcPeeringConnection); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.Status.Message)))
@@ -3019,7 +3019,7 @@ func waitVPNConnectionCreated(ctx context.Context, conn *ec2.Client, id string)
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VpnConnection); ok {
 		return output, err
@@ -3041,7 +3041,7 @@ func waitVPNConnectionDeleted(ctx context.Context, conn *ec2.Client, id string)
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VpnConnection); ok {
 		return output, err
@@ -3061,7 +3061,7 @@ func waitVPNConnectionRouteCreated(ctx context.Context, conn *ec2.Client, vpnCon
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VpnStaticRoute); ok {
 		return output, err
@@ -3081,7 +3081,7 @@ func waitVPNConnectionRouteDeleted(ctx context.Context, conn *ec2.Client, vpnCon
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VpnStaticRoute); ok {
 		return output, err
@@ -3103,7 +3103,7 @@ func waitVPNConnectionUpdated(ctx context.Context, conn *ec2.Client, id string)
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VpnConnection); ok {
 		return output, err
@@ -3125,7 +3125,7 @@ func waitVPNGatewayCreated(ctx context.Context, conn *ec2.Client, id string) (*a
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VpnGateway); ok {
 		return output, err
@@ -3147,7 +3147,7 @@ func waitVPNGatewayDeleted(ctx context.Context, conn *ec2.Client, id string) (*a
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VpnGateway); ok {
 		return output, err
@@ -3167,7 +3167,7 @@ func waitVPNGatewayVPCAttachmentAttached(ctx context.Context, conn *ec2.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VpcAttachment); ok {
 		return output, err
@@ -3187,7 +3187,7 @@ func waitVPNGatewayVPCAttachmentDetached(ctx context.Context, conn *ec2.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.VpcAttachment); ok {
 		return output, err
@@ -450,7 +450,7 @@ func waitClusterAvailable(ctx context.Context, conn *ecs.Client, arn string) (*a
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*awstypes.Cluster); ok {
 		return v, err
@@ -467,7 +467,7 @@ func waitClusterDeleted(ctx context.Context, conn *ecs.Client, arn string, timeo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*awstypes.Cluster); ok {
 		return v, err
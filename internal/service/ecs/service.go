@@ -1843,7 +1843,7 @@ func waitServiceStable(ctx context.Context, conn *ecs.Client, serviceName, clust
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Service); ok {
 		return output, err
@@ -1861,7 +1861,7 @@ func waitServiceActive(ctx context.Context, conn *ecs.Client, serviceName, clust
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Service); ok {
 		return output, err
@@ -1880,7 +1880,7 @@ func waitServiceInactive(ctx context.Context, conn *ecs.Client, serviceName, clu
 		MinTimeout: 1 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Service); ok {
 		return output, err
@@ -209,7 +209,7 @@ func waitBackupPolicyEnabled(ctx context.Context, conn *efs.Client, id string) (
 		Timeout: backupPoltimeoutcyEnabledTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.BackupPolicy); ok {
 		return output, err
@@ -229,7 +229,7 @@ func waitBackupPolicyDisabled(ctx context.Context, conn *efs.Client, id string)
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.BackupPolicy); ok {
 		return output, err
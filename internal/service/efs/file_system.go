@@ -488,7 +488,7 @@ func waitFileSystemAvailable(ctx context.Context, conn *efs.Client, fileSystemID
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.FileSystemDescription); ok {
 		return output, err
@@ -510,7 +510,7 @@ func waitFileSystemDeleted(ctx context.Context, conn *efs.Client, fileSystemID s
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.FileSystemDescription); ok {
 		return output, err
@@ -297,7 +297,7 @@ func waitReplicationConfigurationCreated(ctx context.Context, conn *efs.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReplicationConfigurationDescription); ok {
 		return output, err
@@ -315,7 +315,7 @@ func waitReplicationConfigurationDeleted(ctx context.Context, conn *efs.Client,
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReplicationConfigurationDescription); ok {
 		return output, err
@@ -815,7 +815,7 @@ func waitClusterCreated(ctx context.Context, conn *eks.Client, name string, time
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Cluster); ok {
 		return output, err
@@ -835,7 +835,7 @@ func waitClusterDeleted(ctx context.Context, conn *eks.Client, name string, time
 		ContinuousTargetOccurence: 3,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Cluster); ok {
 		return output, err
@@ -852,7 +852,7 @@ func waitClusterUpdateSuccessful(ctx context.Context, conn *eks.Client, name, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Update); ok {
 		if status := output.Status; status == types.UpdateStatusCancelled || status == types.UpdateStatusFailed {
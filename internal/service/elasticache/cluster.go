@@ -892,7 +892,7 @@ func waitCacheClusterAvailable(ctx context.Context, conn *elasticache.Client, ca
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.CacheCluster); ok {
 		return output, err
@@ -919,7 +919,7 @@ func waitCacheClusterDeleted(ctx context.Context, conn *elasticache.Client, cach
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.CacheCluster); ok {
 		return output, err
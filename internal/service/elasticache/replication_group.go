@@ -1302,7 +1302,7 @@ func waitReplicationGroupAvailable(ctx context.Context, conn *elasticache.Client
 		Delay:      delay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReplicationGroup); ok {
 		return output, err
@@ -1325,7 +1325,7 @@ func waitReplicationGroupDeleted(ctx context.Context, conn *elasticache.Client,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ReplicationGroup); ok {
 		return output, err
@@ -1397,7 +1397,7 @@ func waitReplicationGroupMemberClustersAvailable(ctx context.Context, conn *elas
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]*awstypes.CacheCluster); ok {
 		return output, err
@@ -300,7 +300,7 @@ func waitVPCEndpointCreated(ctx context.Context, conn *elasticsearchservice.Elas
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -313,7 +313,7 @@ func waitVPCEndpointUpdated(ctx context.Context, conn *elasticsearchservice.Elas
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -326,7 +326,7 @@ func waitVPCEndpointDeleted(ctx context.Context, conn *elasticsearchservice.Elas
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
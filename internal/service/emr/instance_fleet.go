@@ -309,7 +309,7 @@ func resourceInstanceFleetUpdate(ctx context.Context, d *schema.ResourceData, me
 		MinTimeout: 30 * time.Second,
 	}
 
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = tfresource.WaitForStateContext(ctx, stateConf)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for EMR Instance Fleet (%s) update: %s", d.Id(), err)
@@ -457,7 +457,7 @@ func waitForInstanceGroupStateRunning(ctx context.Context, conn *emr.EMR, cluste
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -275,7 +275,7 @@ func waitVirtualClusterDeleted(ctx context.Context, conn *emrcontainers.Client,
 		Delay:   1 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*awstypes.VirtualCluster); ok {
 		return v, err
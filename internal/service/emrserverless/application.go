@@ -500,7 +500,7 @@ func waitApplicationCreated(ctx context.Context, conn *emrserverless.Client, id
 		Delay:      delay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Application); ok {
 		if stateChangeReason := output.StateDetails; stateChangeReason != nil {
@@ -528,7 +528,7 @@ func waitApplicationTerminated(ctx context.Context, conn *emrserverless.Client,
 		Delay:      delay,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.Application); ok {
 		if stateChangeReason := output.StateDetails; stateChangeReason != nil {
@@ -302,7 +302,7 @@ func waitResourceSetCreated(ctx context.Context, conn *fms.Client, id string, ti
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*fms.GetResourceSetOutput); ok {
 		return out, err
 	}
@@ -320,7 +320,7 @@ func waitResourceSetUpdated(ctx context.Context, conn *fms.Client, id string, ti
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*fms.GetResourceSetOutput); ok {
 		return out, err
 	}
@@ -336,7 +336,7 @@ func waitResourceSetDeleted(ctx context.Context, conn *fms.Client, id string, ti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*fms.GetResourceSetOutput); ok {
 		return out, err
 	}
@@ -789,7 +789,7 @@ func waitFileSystemCreated(ctx context.Context, conn *fsx.Client, id string, tim
 		ContinuousTargetOccurence: 3,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.FileSystem); ok {
 		if status, details := output.Lifecycle, output.FailureDetails; status == awstypes.FileSystemLifecycleFailed && details != nil {
@@ -811,7 +811,7 @@ func waitFileSystemUpdated(ctx context.Context, conn *fsx.Client, id string, sta
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.FileSystem); ok {
 		switch status := output.Lifecycle; status {
@@ -851,7 +851,7 @@ func waitFileSystemDeleted(ctx context.Context, conn *fsx.Client, id string, tim
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.FileSystem); ok {
 		if status, details := output.Lifecycle, output.FailureDetails; status == awstypes.FileSystemLifecycleFailed && details != nil {
@@ -906,7 +906,7 @@ func waitFileSystemAdministrativeActionCompleted(ctx context.Context, conn *fsx.
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.AdministrativeAction); ok {
 		if status, details := output.Status, output.FailureDetails; status == awstypes.StatusFailed && details != nil {
@@ -694,7 +694,7 @@ func waitVolumeCreated(ctx context.Context, conn *fsx.Client, id string, timeout
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Volume); ok {
 		if output.Lifecycle == awstypes.VolumeLifecycleFailed && output.LifecycleTransitionReason != nil {
@@ -716,7 +716,7 @@ func waitVolumeUpdated(ctx context.Context, conn *fsx.Client, id string, startTi
 		Delay:   150 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Volume); ok {
 		switch output.Lifecycle {
@@ -756,7 +756,7 @@ func waitVolumeDeleted(ctx context.Context, conn *fsx.Client, id string, timeout
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Volume); ok {
 		if output.Lifecycle == awstypes.VolumeLifecycleFailed && output.LifecycleTransitionReason != nil {
@@ -811,7 +811,7 @@ func waitVolumeAdministrativeActionCompleted(ctx context.Context, conn *fsx.Clie
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.AdministrativeAction); ok {
 		if output.Status == awstypes.StatusFailed && output.FailureDetails != nil {
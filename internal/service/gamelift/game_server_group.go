@@ -408,7 +408,7 @@ func waitGameServerGroupActive(ctx context.Context, conn *gamelift.Client, name
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.GameServerGroup); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
@@ -430,7 +430,7 @@ func waitGameServerGroupTerminated(ctx context.Context, conn *gamelift.Client, n
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.GameServerGroup); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
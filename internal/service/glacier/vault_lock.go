@@ -221,7 +221,7 @@ func waitVaultLockComplete(ctx context.Context, conn *glacier.Client, name strin
 		Timeout: 5 * time.Minute,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
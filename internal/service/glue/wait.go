@@ -35,7 +35,7 @@ func waitMLTransformDeleted(ctx context.Context, conn *glue.Client, transformId
 		Timeout: mlTransformDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*glue.GetMLTransformOutput); ok {
 		return output, err
@@ -53,7 +53,7 @@ func waitRegistryDeleted(ctx context.Context, conn *glue.Client, registryID stri
 		Timeout: registryDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*glue.GetRegistryOutput); ok {
 		return output, err
@@ -71,7 +71,7 @@ func waitSchemaAvailable(ctx context.Context, conn *glue.Client, registryID stri
 		Timeout: schemaAvailableTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*glue.GetSchemaOutput); ok {
 		return output, err
@@ -89,7 +89,7 @@ func waitSchemaDeleted(ctx context.Context, conn *glue.Client, registryID string
 		Timeout: schemaDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*glue.GetSchemaOutput); ok {
 		return output, err
@@ -107,7 +107,7 @@ func waitSchemaVersionAvailable(ctx context.Context, conn *glue.Client, registry
 		Timeout: schemaVersionAvailableTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*glue.GetSchemaVersionOutput); ok {
 		return output, err
@@ -132,7 +132,7 @@ func waitTriggerCreated(ctx context.Context, conn *glue.Client, triggerName stri
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*glue.GetTriggerOutput); ok {
 		return output, err
@@ -150,7 +150,7 @@ func waitTriggerDeleted(ctx context.Context, conn *glue.Client, triggerName stri
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*glue.GetTriggerOutput); ok {
 		return output, err
@@ -167,7 +167,7 @@ func waitDevEndpointCreated(ctx context.Context, conn *glue.Client, name string)
 		Timeout: 15 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DevEndpoint); ok {
 		if status := aws.ToString(output.Status); status == devEndpointStatusFailed {
@@ -188,7 +188,7 @@ func waitDevEndpointDeleted(ctx context.Context, conn *glue.Client, name string)
 		Timeout: 15 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DevEndpoint); ok {
 		if status := aws.ToString(output.Status); status == devEndpointStatusFailed {
@@ -209,7 +209,7 @@ func waitPartitionIndexCreated(ctx context.Context, conn *glue.Client, id string
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.PartitionIndexDescriptor); ok {
 		return output, err
@@ -226,7 +226,7 @@ func waitPartitionIndexDeleted(ctx context.Context, conn *glue.Client, id string
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.PartitionIndexDescriptor); ok {
 		return output, err
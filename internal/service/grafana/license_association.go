@@ -177,7 +177,7 @@ func waitLicenseAssociationCreated(ctx context.Context, conn *grafana.Client, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.WorkspaceDescription); ok {
 		return output, err
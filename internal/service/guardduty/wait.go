@@ -11,6 +11,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/guardduty/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -40,7 +41,7 @@ func waitAdminAccountEnabled(ctx context.Context, conn *guardduty.Client, adminA
 		Timeout: adminAccountEnabledTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.AdminAccount); ok {
 		return output, err
@@ -58,7 +59,7 @@ func waitAdminAccountNotFound(ctx context.Context, conn *guardduty.Client, admin
 		Timeout: adminAccountNotFoundTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.AdminAccount); ok {
 		return output, err
@@ -76,7 +77,7 @@ func waitPublishingDestinationCreated(ctx context.Context, conn *guardduty.Clien
 		Timeout: publishingDestinationCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*guardduty.CreatePublishingDestinationOutput); ok {
 		return v, err
@@ -31,6 +31,7 @@ var (
 	ResourceUserSSHKey                = resourceUserSSHKey
 	ResourceVirtualMFADevice          = resourceVirtualMFADevice
 
+	EvaluatePolicies                    = evaluatePolicies
 	FindAccessKeyByTwoPartKey           = findAccessKeyByTwoPartKey
 	FindAccountPasswordPolicy           = findAccountPasswordPolicy
 	FindAttachedGroupPolicies           = findAttachedGroupPolicies
@@ -49,5 +50,10 @@ var (
 	FindSSHPublicKeyByThreePartKey      = findSSHPublicKeyByThreePartKey
 	FindUserByName                      = findUserByName
 	FindVirtualMFADeviceBySerialNumber  = findVirtualMFADeviceBySerialNumber
+	LintPolicy                          = lintPolicy
 	SESSMTPPasswordFromSecretKeySigV4   = sesSMTPPasswordFromSecretKeySigV4
 )
+
+type (
+	PolicyEvaluationInput = policyEvaluationInput
+)
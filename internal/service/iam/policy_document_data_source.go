@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -17,7 +18,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
 )
 
 var dataSourcePolicyDocumentVarReplacer = strings.NewReplacer("&{", "${")
@@ -60,6 +63,11 @@ func dataSourcePolicyDocument() *schema.Resource {
 			}
 
 			return map[string]*schema.Schema{
+				"catalog_validation": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
 				names.AttrJSON: {
 					Type:     schema.TypeString,
 					Computed: true,
@@ -210,6 +218,15 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 		doc.Id = policyID.(string)
 	}
 
+	var catalog *data.IAMCatalog
+	if d.Get("catalog_validation").(bool) {
+		var err error
+		catalog, err = data.ReadIAMCatalog()
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading IAM catalog: %s", err)
+		}
+	}
+
 	if cfgStmts, hasCfgStmts := d.GetOk("statement"); hasCfgStmts {
 		var cfgStmtIntf = cfgStmts.([]interface{})
 		stmts := make([]*IAMPolicyStatement, len(cfgStmtIntf))
@@ -281,6 +298,10 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 				}
 			}
 
+			if catalog != nil {
+				diags = dataSourcePolicyDocumentCheckCatalog(diags, catalog, i, cfgStmt)
+			}
+
 			stmts[i] = stmt
 		}
 
@@ -392,3 +413,31 @@ func dataSourcePolicyDocumentMakePrincipals(in []interface{}, version string) (I
 	}
 	return IAMPolicyStatementPrincipalSet(out), nil
 }
+
+// dataSourcePolicyDocumentCheckCatalog warns about a statement's actions and condition keys that aren't in the IAM catalog.
+func dataSourcePolicyDocumentCheckCatalog(diags diag.Diagnostics, catalog *data.IAMCatalog, i int, cfgStmt map[string]interface{}) diag.Diagnostics {
+	for _, k := range []string{names.AttrActions, "not_actions"} {
+		actions := flex.ExpandStringValueSet(cfgStmt[k].(*schema.Set))
+		slices.Sort(actions)
+
+		for _, action := range actions {
+			if err := catalog.CheckAction(action); err != nil {
+				diags = sdkdiag.AppendWarningf(diags, "statement %d: %s: %s", i, k, err)
+			}
+		}
+	}
+
+	var variables []string
+	for _, condition := range cfgStmt[names.AttrCondition].(*schema.Set).List() {
+		variables = append(variables, condition.(map[string]interface{})["variable"].(string))
+	}
+	slices.Sort(variables)
+
+	for _, variable := range slices.Compact(variables) {
+		if err := catalog.CheckConditionKey(variable); err != nil {
+			diags = sdkdiag.AppendWarningf(diags, "statement %d: condition: %s", i, err)
+		}
+	}
+
+	return diags
+}
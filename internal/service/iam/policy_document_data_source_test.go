@@ -114,6 +114,27 @@ func TestAccIAMPolicyDocumentDataSource_conditionWithBoolValue(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicyDocumentDataSource_catalogValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Actions and condition keys that aren't in the catalog produce warnings, not errors.
+				Config: testAccPolicyDocumentConfig_catalogValidation,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_iam_policy_document.test", "catalog_validation", acctest.CtTrue),
+					acctest.CheckResourceAttrEquivalentJSON("data.aws_iam_policy_document.test", names.AttrJSON,
+						testAccPolicyDocumentCatalogValidationExpectedJSON,
+					),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIAMPolicyDocumentDataSource_source(t *testing.T) {
 	// This really ought to be able to be a unit test rather than an
 	// acceptance test, but just instantiating the AWS provider requires
@@ -1471,6 +1492,45 @@ EOF
 }
 `
 
+const testAccPolicyDocumentConfig_catalogValidation = `
+data "aws_iam_policy_document" "test" {
+  catalog_validation = true
+
+  statement {
+    actions = [
+      "sqs:SendMessages",
+      "sqs:Receive*",
+    ]
+    resources = ["*"]
+
+    condition {
+      test     = "StringEquals"
+      variable = "aws:PrincipleTag/team"
+      values   = ["example"]
+    }
+  }
+}
+`
+
+const testAccPolicyDocumentCatalogValidationExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "sqs:SendMessages",
+        "sqs:Receive*"
+      ],
+      "Resource": "*",
+      "Condition": {
+        "StringEquals": {
+          "aws:PrincipleTag/team": "example"
+        }
+      }
+    }
+  ]
+}`
+
 func testAccPolicyDocumentConditionWithBoolValueExpectedJSON() string {
 	return fmt.Sprintf(`{
     "Version": "2012-10-17",
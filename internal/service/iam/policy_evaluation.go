@@ -0,0 +1,586 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"cmp"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+// Policy evaluation decisions, as returned by the IAM policy simulator.
+const (
+	policyEvaluationDecisionAllowed      = "allowed"
+	policyEvaluationDecisionExplicitDeny = "explicitDeny"
+	policyEvaluationDecisionImplicitDeny = "implicitDeny"
+)
+
+// Types of policy that take part in an evaluation.
+const (
+	policyEvaluationPolicyTypeIdentity            = "identity"
+	policyEvaluationPolicyTypePermissionsBoundary = "permissions_boundary"
+	policyEvaluationPolicyTypeResource            = "resource"
+	policyEvaluationPolicyTypeServiceControl      = "service_control"
+)
+
+type policyEvaluationInput struct {
+	Action   string
+	Resource string
+	// ARN of the principal making the request, or a service principal name. Only used to match resource policy principals.
+	PrincipalARN string
+	// Request context keys and values. Context keys are case insensitive.
+	Context map[string][]string
+
+	IdentityPolicies          []string
+	PermissionsBoundaryPolicy string
+	ResourcePolicy            string
+	ServiceControlPolicies    []string
+}
+
+type policyEvaluationStatement struct {
+	PolicyType     string
+	PolicyIndex    int
+	StatementIndex int
+	Sid            string
+	Effect         string
+}
+
+type policyEvaluationResult struct {
+	Decision           string
+	MatchedStatements  []policyEvaluationStatement
+	MissingContextKeys []string
+}
+
+// evaluatePolicies evaluates a request against a set of policies using the IAM policy evaluation logic for a principal
+// and resource in the same account: an explicit deny in any policy overrides any allow; if service control policies
+// or a permissions boundary are specified, they must allow the request; and an identity policy or the resource policy
+// must allow the request. Otherwise the request is implicitly denied.
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_evaluation-logic.html
+func evaluatePolicies(input *policyEvaluationInput) (*policyEvaluationResult, error) {
+	e := &policyEvaluator{
+		input:   input,
+		context: make(map[string][]string, len(input.Context)),
+		missing: make(map[string]bool),
+	}
+	for k, v := range input.Context {
+		e.context[strings.ToLower(k)] = v
+	}
+
+	result := &policyEvaluationResult{}
+
+	// Allowed and denied, by policy type.
+	allowed, denied := make(map[string]bool), make(map[string]bool)
+
+	evaluate := func(policyType string, policies ...string) error {
+		for i, policy := range policies {
+			statements, err := e.evaluatePolicy(policyType, i, policy)
+			if err != nil {
+				return fmt.Errorf("%s policy %d: %w", strings.ReplaceAll(policyType, "_", " "), i, err)
+			}
+
+			for _, statement := range statements {
+				switch statement.Effect {
+				case "Allow":
+					allowed[policyType] = true
+				case "Deny":
+					denied[policyType] = true
+				}
+			}
+
+			result.MatchedStatements = append(result.MatchedStatements, statements...)
+		}
+
+		return nil
+	}
+
+	if err := evaluate(policyEvaluationPolicyTypeServiceControl, input.ServiceControlPolicies...); err != nil {
+		return nil, err
+	}
+	if input.ResourcePolicy != "" {
+		if err := evaluate(policyEvaluationPolicyTypeResource, input.ResourcePolicy); err != nil {
+			return nil, err
+		}
+	}
+	if err := evaluate(policyEvaluationPolicyTypeIdentity, input.IdentityPolicies...); err != nil {
+		return nil, err
+	}
+	if input.PermissionsBoundaryPolicy != "" {
+		if err := evaluate(policyEvaluationPolicyTypePermissionsBoundary, input.PermissionsBoundaryPolicy); err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case len(denied) > 0:
+		result.Decision = policyEvaluationDecisionExplicitDeny
+	case len(input.ServiceControlPolicies) > 0 && !allowed[policyEvaluationPolicyTypeServiceControl]:
+		result.Decision = policyEvaluationDecisionImplicitDeny
+	case input.PermissionsBoundaryPolicy != "" && !allowed[policyEvaluationPolicyTypePermissionsBoundary]:
+		result.Decision = policyEvaluationDecisionImplicitDeny
+	case allowed[policyEvaluationPolicyTypeIdentity] || allowed[policyEvaluationPolicyTypeResource]:
+		result.Decision = policyEvaluationDecisionAllowed
+	default:
+		result.Decision = policyEvaluationDecisionImplicitDeny
+	}
+
+	for k := range e.missing {
+		result.MissingContextKeys = append(result.MissingContextKeys, k)
+	}
+	slices.Sort(result.MissingContextKeys)
+
+	return result, nil
+}
+
+type policyEvaluator struct {
+	input *policyEvaluationInput
+	// Request context, with lowercase keys.
+	context map[string][]string
+	// Context keys referenced by applicable statements but not in the request context.
+	missing map[string]bool
+}
+
+// evaluatePolicy returns the statements in a policy that apply to the request.
+func (e *policyEvaluator) evaluatePolicy(policyType string, policyIndex int, policy string) ([]policyEvaluationStatement, error) {
+	doc, err := decodePolicyDocument(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	var statements []policyEvaluationStatement
+
+	for i, statement := range doc.Statements {
+		if statement == nil {
+			continue
+		}
+
+		if effect := statement.Effect; effect != "Allow" && effect != "Deny" {
+			return nil, fmt.Errorf("statement %d: Effect (%s) must be Allow or Deny", i, effect)
+		}
+
+		ok, err := e.statementApplies(policyType, statement)
+		if err != nil {
+			return nil, fmt.Errorf("statement %d: %w", i, err)
+		}
+
+		if ok {
+			statements = append(statements, policyEvaluationStatement{
+				PolicyType:     policyType,
+				PolicyIndex:    policyIndex,
+				StatementIndex: i,
+				Sid:            statement.Sid,
+				Effect:         statement.Effect,
+			})
+		}
+	}
+
+	return statements, nil
+}
+
+func (e *policyEvaluator) statementApplies(policyType string, statement *IAMPolicyStatement) (bool, error) {
+	switch {
+	case statement.Actions != nil:
+		if !e.anyMatches(policyStrings(statement.Actions), e.input.Action, policyPatternWildcard, true) {
+			return false, nil
+		}
+	case statement.NotActions != nil:
+		if e.anyMatches(policyStrings(statement.NotActions), e.input.Action, policyPatternWildcard, true) {
+			return false, nil
+		}
+	default:
+		return false, nil
+	}
+
+	// Role trust policies have no Resource element.
+	switch {
+	case statement.Resources != nil:
+		if !e.anyMatches(policyStrings(statement.Resources), e.input.Resource, policyPatternARN, false) {
+			return false, nil
+		}
+	case statement.NotResources != nil:
+		if e.anyMatches(policyStrings(statement.NotResources), e.input.Resource, policyPatternARN, false) {
+			return false, nil
+		}
+	}
+
+	// Only resource policies specify principals.
+	if policyType == policyEvaluationPolicyTypeResource {
+		switch {
+		case statement.Principals != nil:
+			if !e.principalMatches(statement.Principals) {
+				return false, nil
+			}
+		case statement.NotPrincipals != nil:
+			if e.principalMatches(statement.NotPrincipals) {
+				return false, nil
+			}
+		default:
+			return false, nil
+		}
+	}
+
+	// All conditions must be met. Evaluate every condition so that all missing context keys are reported.
+	ok := true
+	for _, condition := range statement.Conditions {
+		v, err := e.conditionMatches(condition)
+		if err != nil {
+			return false, err
+		}
+		ok = ok && v
+	}
+
+	return ok, nil
+}
+
+// anyMatches returns whether a value matches any of the specified policy patterns.
+func (e *policyEvaluator) anyMatches(patterns []string, value string, mode policyPatternMode, ignoreCase bool) bool {
+	for _, pattern := range patterns {
+		if mode == policyPatternARN && pattern == "*" {
+			return true
+		}
+
+		if re, ok := e.compilePattern(pattern, mode, ignoreCase); ok && re.MatchString(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e *policyEvaluator) principalMatches(principals IAMPolicyStatementPrincipalSet) bool {
+	principalARN := e.input.PrincipalARN
+
+	for _, principal := range principals {
+		// "Principal": "*".
+		if principal.Type == "*" {
+			return true
+		}
+
+		for _, identifier := range policyStrings(principal.Identifiers) {
+			if identifier == principalARN || (principal.Type == "AWS" && awsPrincipalMatches(identifier, principalARN)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// awsPrincipalMatches returns whether an AWS principal in a resource policy matches the ARN of the principal making
+// the request. An account ID or account root user ARN matches every principal in the account, and a role ARN matches
+// the role's sessions.
+func awsPrincipalMatches(identifier, principalARN string) bool {
+	if identifier == "*" {
+		return true
+	}
+
+	principal, err := arn.Parse(principalARN)
+	if err != nil {
+		return false
+	}
+
+	if identifier == principal.AccountID {
+		return true
+	}
+
+	id, err := arn.Parse(identifier)
+	if err != nil || id.AccountID != principal.AccountID || id.Partition != principal.Partition {
+		return false
+	}
+
+	if id.Service == "iam" && id.Resource == "root" {
+		return true
+	}
+
+	// e.g. arn:aws:sts::123456789012:assumed-role/Name/session matches arn:aws:iam::123456789012:role/path/Name.
+	if v, ok := strings.CutPrefix(principal.Resource, "assumed-role/"); ok && principal.Service == "sts" && id.Service == "iam" {
+		if roleName, _, ok := strings.Cut(v, "/"); ok && strings.HasPrefix(id.Resource, "role/") {
+			return id.Resource[strings.LastIndex(id.Resource, "/")+1:] == roleName
+		}
+	}
+
+	return false
+}
+
+type conditionMatchFunc func(e *policyEvaluator, requestValue, policyValue string) bool
+
+// conditionOperatorMatchers are the value matchers for each condition operator.
+// Negated operators are met if no request value matches any policy value.
+var conditionOperatorMatchers = map[string]struct {
+	match   conditionMatchFunc
+	negated bool
+}{
+	"ArnEquals":                 {patternConditionMatcher(policyPatternARN, false), false},
+	"ArnLike":                   {patternConditionMatcher(policyPatternARN, false), false},
+	"ArnNotEquals":              {patternConditionMatcher(policyPatternARN, false), true},
+	"ArnNotLike":                {patternConditionMatcher(policyPatternARN, false), true},
+	"BinaryEquals":              {func(_ *policyEvaluator, requestValue, policyValue string) bool { return requestValue == policyValue }, false},
+	"Bool":                      {boolConditionMatcher, false},
+	"DateEquals":                {dateConditionMatcher(func(c int) bool { return c == 0 }), false},
+	"DateGreaterThan":           {dateConditionMatcher(func(c int) bool { return c > 0 }), false},
+	"DateGreaterThanEquals":     {dateConditionMatcher(func(c int) bool { return c >= 0 }), false},
+	"DateLessThan":              {dateConditionMatcher(func(c int) bool { return c < 0 }), false},
+	"DateLessThanEquals":        {dateConditionMatcher(func(c int) bool { return c <= 0 }), false},
+	"DateNotEquals":             {dateConditionMatcher(func(c int) bool { return c == 0 }), true},
+	"IpAddress":                 {ipAddressConditionMatcher, false},
+	"NotIpAddress":              {ipAddressConditionMatcher, true},
+	"NumericEquals":             {numericConditionMatcher(func(c int) bool { return c == 0 }), false},
+	"NumericGreaterThan":        {numericConditionMatcher(func(c int) bool { return c > 0 }), false},
+	"NumericGreaterThanEquals":  {numericConditionMatcher(func(c int) bool { return c >= 0 }), false},
+	"NumericLessThan":           {numericConditionMatcher(func(c int) bool { return c < 0 }), false},
+	"NumericLessThanEquals":     {numericConditionMatcher(func(c int) bool { return c <= 0 }), false},
+	"NumericNotEquals":          {numericConditionMatcher(func(c int) bool { return c == 0 }), true},
+	"StringEquals":              {patternConditionMatcher(policyPatternLiteral, false), false},
+	"StringEqualsIgnoreCase":    {patternConditionMatcher(policyPatternLiteral, true), false},
+	"StringLike":                {patternConditionMatcher(policyPatternWildcard, false), false},
+	"StringNotEquals":           {patternConditionMatcher(policyPatternLiteral, false), true},
+	"StringNotEqualsIgnoreCase": {patternConditionMatcher(policyPatternLiteral, true), true},
+	"StringNotLike":             {patternConditionMatcher(policyPatternWildcard, false), true},
+}
+
+// conditionMatches returns whether a condition is met.
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_condition_operators.html
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_condition-single-vs-multi-valued-context-keys.html
+func (e *policyEvaluator) conditionMatches(condition IAMPolicyStatementCondition) (bool, error) {
+	op, ok := parseConditionOperator(condition.Test)
+	if !ok {
+		return false, fmt.Errorf("condition operator (%s) is not a valid IAM condition operator", condition.Test)
+	}
+
+	policyValues := policyStrings(condition.Values)
+	requestValues, present := e.context[strings.ToLower(condition.Variable)]
+	present = present && len(requestValues) > 0
+
+	if op.Operator == "Null" {
+		for _, v := range policyValues {
+			if null, err := strconv.ParseBool(v); err == nil && null != present {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	matcher := conditionOperatorMatchers[op.Operator]
+
+	if !present {
+		e.missing[condition.Variable] = true
+
+		switch {
+		case op.IfExists, op.SetOperator == "ForAllValues":
+			return true, nil
+		case op.SetOperator == "ForAnyValue":
+			return false, nil
+		default:
+			return matcher.negated, nil
+		}
+	}
+
+	matches := func(requestValue string) bool {
+		for _, policyValue := range policyValues {
+			if matcher.match(e, requestValue, policyValue) {
+				return true
+			}
+		}
+		return false
+	}
+	met := func(requestValue string) bool {
+		return matches(requestValue) != matcher.negated
+	}
+
+	switch op.SetOperator {
+	case "ForAllValues":
+		return !slices.ContainsFunc(requestValues, func(v string) bool { return !met(v) }), nil
+	case "ForAnyValue":
+		return slices.ContainsFunc(requestValues, met), nil
+	default:
+		return slices.ContainsFunc(requestValues, matches) != matcher.negated, nil
+	}
+}
+
+func patternConditionMatcher(mode policyPatternMode, ignoreCase bool) conditionMatchFunc {
+	return func(e *policyEvaluator, requestValue, policyValue string) bool {
+		re, ok := e.compilePattern(policyValue, mode, ignoreCase)
+		return ok && re.MatchString(requestValue)
+	}
+}
+
+func boolConditionMatcher(_ *policyEvaluator, requestValue, policyValue string) bool {
+	a, err := strconv.ParseBool(requestValue)
+	if err != nil {
+		return false
+	}
+	b, err := strconv.ParseBool(policyValue)
+	if err != nil {
+		return false
+	}
+
+	return a == b
+}
+
+func dateConditionMatcher(ok func(c int) bool) conditionMatchFunc {
+	return func(_ *policyEvaluator, requestValue, policyValue string) bool {
+		a, err := parsePolicyDate(requestValue)
+		if err != nil {
+			return false
+		}
+		b, err := parsePolicyDate(policyValue)
+		if err != nil {
+			return false
+		}
+
+		return ok(a.Compare(b))
+	}
+}
+
+// parsePolicyDate parses an ISO 8601 date and time, an ISO 8601 date or a Unix epoch time in seconds.
+func parsePolicyDate(s string) (time.Time, error) {
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(v, 0), nil
+	}
+
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if v, err := time.Parse(layout, s); err == nil {
+			return v, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("date (%s) must be an ISO 8601 date or a Unix epoch time", s)
+}
+
+func ipAddressConditionMatcher(_ *policyEvaluator, requestValue, policyValue string) bool {
+	addr, err := netip.ParseAddr(requestValue)
+	if err != nil {
+		return false
+	}
+
+	prefix, err := netip.ParsePrefix(policyValue)
+	if err != nil {
+		v, err := netip.ParseAddr(policyValue)
+		if err != nil {
+			return false
+		}
+		prefix = netip.PrefixFrom(v, v.BitLen())
+	}
+
+	return prefix.Contains(addr.Unmap())
+}
+
+func numericConditionMatcher(ok func(c int) bool) conditionMatchFunc {
+	return func(_ *policyEvaluator, requestValue, policyValue string) bool {
+		a, err := strconv.ParseFloat(requestValue, 64)
+		if err != nil {
+			return false
+		}
+		b, err := strconv.ParseFloat(policyValue, 64)
+		if err != nil {
+			return false
+		}
+
+		return ok(cmp.Compare(a, b))
+	}
+}
+
+type policyPatternMode int
+
+const (
+	// No wildcards.
+	policyPatternLiteral policyPatternMode = iota
+	// `*` matches any sequence of characters and `?` matches any single character.
+	policyPatternWildcard
+	// As policyPatternWildcard, but wildcards do not match across the colons that separate the
+	// partition, service, Region and account ID of an ARN.
+	policyPatternARN
+)
+
+// compilePattern compiles a policy value, which may contain policy variables, into an anchored regular expression.
+// It returns false if the value references a policy variable that isn't in the request context and has no default value.
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_variables.html
+func (e *policyEvaluator) compilePattern(s string, mode policyPatternMode, ignoreCase bool) (*regexp.Regexp, bool) {
+	var sb strings.Builder
+
+	sb.WriteString("(?s)")
+	if ignoreCase {
+		sb.WriteString("(?i)")
+	}
+	sb.WriteString("^")
+
+	// ARN components, of which the first five are separated by colons.
+	component := 0
+
+	for len(s) > 0 {
+		if v, ok := strings.CutPrefix(s, "${"); ok {
+			if variable, rest, ok := strings.Cut(v, "}"); ok {
+				value, ok := e.policyVariable(variable)
+				if !ok {
+					return nil, false
+				}
+				sb.WriteString(regexp.QuoteMeta(value))
+				s = rest
+				continue
+			}
+		}
+
+		c, size := utf8.DecodeRuneInString(s)
+		s = s[size:]
+
+		switch {
+		case c == '*' && mode == policyPatternARN && component < 5:
+			sb.WriteString("[^:]*")
+		case c == '*' && mode != policyPatternLiteral:
+			sb.WriteString(".*")
+		case c == '?' && mode == policyPatternARN && component < 5:
+			sb.WriteString("[^:]")
+		case c == '?' && mode != policyPatternLiteral:
+			sb.WriteString(".")
+		default:
+			if c == ':' {
+				component++
+			}
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	sb.WriteString("$")
+
+	re, err := regexp.Compile(sb.String())
+	if err != nil {
+		return nil, false
+	}
+
+	return re, true
+}
+
+// policyVariable returns the value of a policy variable, e.g. "aws:username" or "aws:PrincipalTag/team, 'none'".
+func (e *policyEvaluator) policyVariable(variable string) (string, bool) {
+	switch variable {
+	case "*", "?", "$":
+		return variable, true
+	}
+
+	key, defaultValue, hasDefault := strings.Cut(variable, ",")
+	key = strings.TrimSpace(key)
+
+	if v := e.context[strings.ToLower(key)]; len(v) == 1 {
+		return v[0], true
+	} else if len(v) == 0 {
+		e.missing[key] = true
+	}
+
+	if hasDefault {
+		if v, ok := strings.CutPrefix(strings.TrimSpace(defaultValue), "'"); ok {
+			if v, ok := strings.CutSuffix(v, "'"); ok {
+				return v, true
+			}
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_iam_policy_evaluation", name="Policy Evaluation")
+func dataSourcePolicyEvaluation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePolicyEvaluationRead,
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			names.AttrAction: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z-]+:[0-9A-Za-z]+$`), "must be in the format service:ActionName"),
+				Description:  `Name of the action to evaluate, like "s3:GetObject".`,
+			},
+			"context": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrKey: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `The key name of the context entry, such as "aws:SourceIp".`,
+						},
+						names.AttrType: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ContextKeyTypeEnum](),
+							Description:      `The type of the context entry. Types other than the list types take exactly one value.`,
+						},
+						names.AttrValues: {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Description: `One or more values to assign to the context key.`,
+						},
+					},
+				},
+				Description: `Each block specifies one request context entry. These are the properties used in the 'Condition' element of an IAM policy, and in policy variables.`,
+			},
+			"identity_policies_json": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+				Description: `Identity-based policies of the principal making the request.`,
+			},
+			"permissions_boundary_policy_json": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+				Description:  `Permissions boundary of the principal making the request.`,
+			},
+			"principal_arn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `ARN of the principal making the request, or a service principal name such as "lambda.amazonaws.com". Used to match the Principal element of the resource policy.`,
+			},
+			names.AttrResourceARN: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "*",
+				Description: `ARN of the resource that the action is performed on. Defaults to "*".`,
+			},
+			"resource_policy_json": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+				Description:  `Resource-based policy of the resource that the action is performed on.`,
+			},
+			"service_control_policies_json": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+				Description: `Service control policies that apply to the account of the principal making the request.`,
+			},
+
+			// Result Attributes
+			"allowed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `A summary of attribute "decision" which is true only if the decision is "allowed".`,
+			},
+			"decision": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The decision: "allowed", "explicitDeny", or "implicitDeny".`,
+			},
+			"matched_statements": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"effect": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Effect of the statement, "Allow" or "Deny".`,
+						},
+						"policy_index": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `Index of the policy in the list of policies of its type.`,
+						},
+						"policy_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Type of the policy: "identity", "permissions_boundary", "resource", or "service_control".`,
+						},
+						"sid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Statement ID of the statement.`,
+						},
+						"statement_index": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `Index of the statement in the policy.`,
+						},
+					},
+				},
+				Description: `Statements that apply to the request.`,
+			},
+			"missing_context_keys": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: `Set of context keys that were referenced by the applicable statements but not included in the request context.`,
+			},
+		},
+	}
+}
+
+func dataSourcePolicyEvaluationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	input := &policyEvaluationInput{
+		Action:                    d.Get(names.AttrAction).(string),
+		Context:                   make(map[string][]string),
+		IdentityPolicies:          flex.ExpandStringValueList(d.Get("identity_policies_json").([]interface{})),
+		PermissionsBoundaryPolicy: d.Get("permissions_boundary_policy_json").(string),
+		PrincipalARN:              d.Get("principal_arn").(string),
+		Resource:                  d.Get(names.AttrResourceARN).(string),
+		ResourcePolicy:            d.Get("resource_policy_json").(string),
+		ServiceControlPolicies:    flex.ExpandStringValueList(d.Get("service_control_policies_json").([]interface{})),
+	}
+
+	for _, tfMapRaw := range d.Get("context").(*schema.Set).List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+		key, typ := tfMap[names.AttrKey].(string), tfMap[names.AttrType].(string)
+		values := flex.ExpandStringValueSet(tfMap[names.AttrValues].(*schema.Set))
+
+		if !strings.HasSuffix(typ, "List") && len(values) != 1 {
+			return sdkdiag.AppendErrorf(diags, "context key (%s): type %s takes exactly one value", key, typ)
+		}
+
+		input.Context[key] = values
+	}
+
+	result, err := evaluatePolicies(input)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "evaluating IAM Policies: %s", err)
+	}
+
+	tfList := make([]interface{}, 0, len(result.MatchedStatements))
+	for _, statement := range result.MatchedStatements {
+		tfList = append(tfList, map[string]interface{}{
+			"effect":          statement.Effect,
+			"policy_index":    statement.PolicyIndex,
+			"policy_type":     statement.PolicyType,
+			"sid":             statement.Sid,
+			"statement_index": statement.StatementIndex,
+		})
+	}
+
+	d.SetId("-")
+	d.Set("allowed", result.Decision == policyEvaluationDecisionAllowed)
+	d.Set("decision", result.Decision)
+	d.Set("matched_statements", tfList)
+	d.Set("missing_context_keys", result.MissingContextKeys)
+
+	return diags
+}
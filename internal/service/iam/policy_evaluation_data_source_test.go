@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIAMPolicyEvaluationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_evaluation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyEvaluationDataSourceConfig_basic("eu-west-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "allowed", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "decision", "allowed"),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_statements.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_statements.0.policy_type", "service_control"),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_statements.0.effect", "Allow"),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_statements.1.policy_type", "identity"),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_statements.1.sid", "ReadHome"),
+					resource.TestCheckResourceAttr(dataSourceName, "missing_context_keys.#", acctest.Ct0),
+				),
+			},
+			{
+				Config: testAccPolicyEvaluationDataSourceConfig_basic("us-east-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "allowed", acctest.CtFalse),
+					resource.TestCheckResourceAttr(dataSourceName, "decision", "explicitDeny"),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_statements.#", acctest.Ct3),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_statements.1.policy_type", "service_control"),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_statements.1.effect", "Deny"),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_statements.1.policy_index", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyEvaluationDataSource_resourcePolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_evaluation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyEvaluationDataSourceConfig_resourcePolicy,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "decision", "implicitDeny"),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_statements.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, "missing_context_keys.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "missing_context_keys.*", "aws:SourceAccount"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyEvaluationDataSource_invalidConditionOperator(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyEvaluationDataSourceConfig_invalidConditionOperator,
+				ExpectError: regexache.MustCompile(`condition operator \(StringEqual\) is not a valid IAM condition operator`),
+			},
+		},
+	})
+}
+
+func testAccPolicyEvaluationDataSourceConfig_basic(region string) string {
+	return `
+data "aws_partition" "current" {}
+
+data "aws_iam_policy_document" "identity" {
+  statement {
+    sid       = "ReadHome"
+    actions   = ["s3:GetObject"]
+    resources = ["arn:${data.aws_partition.current.partition}:s3:::example/home/$${aws:username}/*"]
+  }
+}
+
+data "aws_iam_policy_document" "deny_outside_eu" {
+  statement {
+    effect    = "Deny"
+    actions   = ["*"]
+    resources = ["*"]
+
+    condition {
+      test     = "StringNotEquals"
+      variable = "aws:RequestedRegion"
+      values   = ["eu-west-1", "eu-central-1"]
+    }
+  }
+}
+
+data "aws_iam_policy_evaluation" "test" {
+  action       = "s3:GetObject"
+  resource_arn = "arn:${data.aws_partition.current.partition}:s3:::example/home/jane/notes.txt"
+
+  identity_policies_json = [data.aws_iam_policy_document.identity.json]
+
+  service_control_policies_json = [
+    jsonencode({
+      Version   = "2012-10-17"
+      Statement = [{ Effect = "Allow", Action = "*", Resource = "*" }]
+    }),
+    data.aws_iam_policy_document.deny_outside_eu.json,
+  ]
+
+  context {
+    key    = "aws:username"
+    type   = "string"
+    values = ["jane"]
+  }
+
+  context {
+    key    = "aws:RequestedRegion"
+    type   = "string"
+    values = ["` + region + `"]
+  }
+}
+`
+}
+
+const testAccPolicyEvaluationDataSourceConfig_resourcePolicy = `
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_iam_policy_evaluation" "test" {
+  action        = "sqs:SendMessage"
+  resource_arn  = "arn:${data.aws_partition.current.partition}:sqs:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:example"
+  principal_arn = "sns.amazonaws.com"
+
+  resource_policy_json = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = { Service = "sns.amazonaws.com" }
+      Action    = "sqs:SendMessage"
+      Resource  = "*"
+      Condition = {
+        StringEquals = {
+          "aws:SourceAccount" = data.aws_caller_identity.current.account_id
+        }
+      }
+    }]
+  })
+}
+`
+
+const testAccPolicyEvaluationDataSourceConfig_invalidConditionOperator = `
+data "aws_iam_policy_evaluation" "test" {
+  action = "s3:GetObject"
+
+  identity_policies_json = [jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "s3:GetObject"
+      Resource = "*"
+      Condition = {
+        StringEqual = {
+          "aws:username" = "jane"
+        }
+      }
+    }]
+  })]
+}
+`
@@ -0,0 +1,342 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func TestEvaluatePolicies(t *testing.T) {
+	t.Parallel()
+
+	const (
+		allowGetObject  = `{"Version":"2012-10-17","Statement":[{"Sid":"Get","Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}]}` // lintignore:AWSAT005
+		allowAll        = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`
+		objectARN       = "arn:aws:s3:::example/data.csv"                         // lintignore:AWSAT005
+		roleARN         = "arn:aws:iam::123456789012:role/path/Reader"            // lintignore:AWSAT005
+		roleSessionARN  = "arn:aws:sts::123456789012:assumed-role/Reader/session" // lintignore:AWSAT005
+		denyOutsideEU   = `{"Version":"2012-10-17","Statement":{"Effect":"Deny","Action":"*","Resource":"*","Condition":{"StringNotEquals":{"aws:RequestedRegion":["eu-west-1","eu-central-1"]}}}}`
+		policyWithTests = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":%s}]}`
+	)
+
+	testcases := map[string]struct {
+		input tfiam.PolicyEvaluationInput
+		// Expected matched statements, as "policy_type policy_index statement_index effect".
+		wantMatched  []string
+		wantDecision string
+		wantMissing  []string
+		wantErr      bool
+	}{
+		"no policies": {
+			input: tfiam.PolicyEvaluationInput{
+				Action:   "s3:GetObject",
+				Resource: objectARN,
+			},
+			wantDecision: "implicitDeny",
+		},
+		"identity allow": {
+			input: tfiam.PolicyEvaluationInput{
+				Action:           "s3:GetObject",
+				Resource:         objectARN,
+				IdentityPolicies: []string{allowGetObject},
+			},
+			wantMatched:  []string{"identity 0 0 Allow"},
+			wantDecision: "allowed",
+		},
+		"action case insensitive wildcard": {
+			input: tfiam.PolicyEvaluationInput{
+				Action:           "s3:GetObject",
+				Resource:         objectARN,
+				IdentityPolicies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"S3:get*","Resource":"*"}]}`},
+			},
+			wantMatched:  []string{"identity 0 0 Allow"},
+			wantDecision: "allowed",
+		},
+		"action mismatch": {
+			input: tfiam.PolicyEvaluationInput{
+				Action:           "s3:PutObject",
+				Resource:         objectARN,
+				IdentityPolicies: []string{allowGetObject},
+			},
+			wantDecision: "implicitDeny",
+		},
+		"resource mismatch": {
+			input: tfiam.PolicyEvaluationInput{
+				Action:           "s3:GetObject",
+				Resource:         "arn:aws:s3:::other/data.csv", // lintignore:AWSAT005
+				IdentityPolicies: []string{allowGetObject},
+			},
+			wantDecision: "implicitDeny",
+		},
+		"resource wildcard does not match across ARN components": {
+			input: tfiam.PolicyEvaluationInput{
+				Action:           "iam:GetRole",
+				Resource:         roleARN,
+				IdentityPolicies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"iam:GetRole","Resource":"arn:aws:*"},{"Effect":"Allow","Action":"iam:GetRole","Resource":"arn:aws:iam::*:role/*"}]}`}, // lintignore:AWSAT005
+			},
+			wantMatched:  []string{"identity 0 1 Allow"},
+			wantDecision: "allowed",
+		},
+		"NotAction": {
+			input: tfiam.PolicyEvaluationInput{
+				Action:           "iam:CreateUser",
+				Resource:         "*",
+				IdentityPolicies: []string{allowAll, `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotAction":["s3:*","ec2:*"],"Resource":"*"}]}`},
+			},
+			wantMatched:  []string{"identity 0 0 Allow", "identity 1 0 Deny"},
+			wantDecision: "explicitDeny",
+		},
+		"explicit deny in SCP overrides allow": {
+			input: tfiam.PolicyEvaluationInput{
+				Action:                 "s3:GetObject",
+				Resource:               objectARN,
+				Context:                map[string][]string{"aws:RequestedRegion": {"us-east-1"}},
+				IdentityPolicies:       []string{allowGetObject},
+				ServiceControlPolicies: []string{allowAll, denyOutsideEU},
+			},
+			wantMatched:  []string{"service_control 0 0 Allow", "service_control 1 0 Deny", "identity 0 0 Allow"},
+			wantDecision: "explicitDeny",
+		},
+		"SCP allows": {
+			input: tfiam.PolicyEvaluationInput{
+				Action:                 "s3:GetObject",
+				Resource:               objectARN,
+				Context:                map[string][]string{"AWS:REQUESTEDREGION": {"eu-west-1"}},
+				IdentityPolicies:       []string{allowGetObject},
+				ServiceControlPolicies: []string{allowAll, denyOutsideEU},
+			},
+			wantMatched:  []string{"service_control 0 0 Allow", "identity 0 0 Allow"},
+			wantDecision: "allowed",
+		},
+		"SCP without allow": {
+			input: tfiam.PolicyEvaluationInput{
+				Action:                 "s3:GetObject",
+				Resource:               objectARN,
+				Context:                map[string][]string{"aws:RequestedRegion": {"eu-west-1"}},
+				IdentityPolicies:       []string{allowGetObject},
+				ServiceControlPolicies: []string{denyOutsideEU},
+			},
+			wantMatched:  []string{"identity 0 0 Allow"},
+			wantDecision: "implicitDeny",
+		},
+		"permissions boundary without allow": {
+			input: tfiam.PolicyEvaluationInput{
+				Action:                    "s3:GetObject",
+				Resource:                  objectARN,
+				IdentityPolicies:          []string{allowAll},
+				PermissionsBoundaryPolicy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:*","Resource":"*"}]}`,
+			},
+			wantMatched:  []string{"identity 0 0 Allow"},
+			wantDecision: "implicitDeny",
+		},
+		"resource policy allows role session": {
+			input: tfiam.PolicyEvaluationInput{
+				Action:         "s3:GetObject",
+				Resource:       objectARN,
+				PrincipalARN:   roleSessionARN,
+				ResourcePolicy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:role/readers/Reader"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}]}`, // lintignore:AWSAT005
+			},
+			wantMatched:  []string{"resource 0 0 Allow"},
+			wantDecision: "allowed",
+		},
+		"resource policy allows account": {
+			input: tfiam.PolicyEvaluationInput{
+				Action:         "s3:GetObject",
+				Resource:       objectARN,
+				PrincipalARN:   roleSessionARN,
+				ResourcePolicy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:root"]},"Action":"s3:*","Resource":"*"}]}`, // lintignore:AWSAT005
+			},
+			wantMatched:  []string{"resource 0 0 Allow"},
+			wantDecision: "allowed",
+		},
+		"resource policy principal mismatch": {
+			input: tfiam.PolicyEvaluationInput{
+				Action:         "s3:GetObject",
+				Resource:       objectARN,
+				PrincipalARN:   roleSessionARN,
+				ResourcePolicy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"111122223333","Service":"lambda.amazonaws.com"},"Action":"s3:*","Resource":"*"}]}`,
+			},
+			wantDecision: "implicitDeny",
+		},
+		"resource policy NotPrincipal": {
+			input: tfiam.PolicyEvaluationInput{
+				Action:           "s3:GetObject",
+				Resource:         objectARN,
+				PrincipalARN:     roleSessionARN,
+				IdentityPolicies: []string{allowGetObject},
+				ResourcePolicy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotPrincipal":{"AWS":"111122223333"},"Action":"s3:*","Resource":"*"}]}`,
+			},
+			wantMatched:  []string{"resource 0 0 Deny", "identity 0 0 Allow"},
+			wantDecision: "explicitDeny",
+		},
+		"policy variable": {
+			input: tfiam.PolicyEvaluationInput{
+				Action:           "s3:GetObject",
+				Resource:         "arn:aws:s3:::example/home/jane/notes.txt", // lintignore:AWSAT005
+				Context:          map[string][]string{"aws:username": {"jane"}},
+				IdentityPolicies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/home/${aws:username}/*"}]}`}, // lintignore:AWSAT005
+			},
+			wantMatched:  []string{"identity 0 0 Allow"},
+			wantDecision: "allowed",
+		},
+		"policy variable missing": {
+			input: tfiam.PolicyEvaluationInput{
+				Action:           "s3:GetObject",
+				Resource:         "arn:aws:s3:::example/home/jane/notes.txt",                                                                                                             // lintignore:AWSAT005
+				IdentityPolicies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/home/${aws:username}/*"}]}`}, // lintignore:AWSAT005
+			},
+			wantDecision: "implicitDeny",
+			wantMissing:  []string{"aws:username"},
+		},
+		"policy variable default and escape": {
+			input: tfiam.PolicyEvaluationInput{
+				Action:           "s3:GetObject",
+				Resource:         "arn:aws:s3:::example/shared/*",                                                                                                                                         // lintignore:AWSAT005
+				IdentityPolicies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/${aws:PrincipalTag/team, 'shared'}/${*}"}]}`}, // lintignore:AWSAT005
+			},
+			wantMatched:  []string{"identity 0 0 Allow"},
+			wantDecision: "allowed",
+			wantMissing:  []string{"aws:PrincipalTag/team"},
+		},
+		"condition StringLike and IpAddress": {
+			input: tfiam.PolicyEvaluationInput{
+				Action:           "s3:GetObject",
+				Resource:         objectARN,
+				Context:          map[string][]string{"aws:PrincipalTag/team": {"data-eng"}, "aws:SourceIp": {"203.0.113.10"}},
+				IdentityPolicies: []string{fmt.Sprintf(policyWithTests, `{"StringLike":{"aws:PrincipalTag/team":["data-*","ml"]},"IpAddress":{"aws:SourceIp":"203.0.113.0/24"}}`)},
+			},
+			wantMatched:  []string{"identity 0 0 Allow"},
+			wantDecision: "allowed",
+		},
+		"condition not met": {
+			input: tfiam.PolicyEvaluationInput{
+				Action:           "s3:GetObject",
+				Resource:         objectARN,
+				Context:          map[string][]string{"aws:PrincipalTag/team": {"data-eng"}, "aws:SourceIp": {"198.51.100.10"}},
+				IdentityPolicies: []string{fmt.Sprintf(policyWithTests, `{"StringLike":{"aws:PrincipalTag/team":["data-*","ml"]},"IpAddress":{"aws:SourceIp":"203.0.113.0/24"}}`)},
+			},
+			wantDecision: "implicitDeny",
+		},
+		"condition missing key": {
+			input: tfiam.PolicyEvaluationInput{
+				Action:           "s3:GetObject",
+				Resource:         objectARN,
+				IdentityPolicies: []string{fmt.Sprintf(policyWithTests, `{"Bool":{"aws:SecureTransport":"true"},"NumericLessThan":{"s3:max-keys":10}}`)},
+			},
+			wantDecision: "implicitDeny",
+			wantMissing:  []string{"aws:SecureTransport", "s3:max-keys"},
+		},
+		"condition IfExists and Null": {
+			input: tfiam.PolicyEvaluationInput{
+				Action:           "s3:GetObject",
+				Resource:         objectARN,
+				IdentityPolicies: []string{fmt.Sprintf(policyWithTests, `{"BoolIfExists":{"aws:MultiFactorAuthPresent":"true"},"Null":{"aws:TokenIssueTime":"true"}}`)},
+			},
+			wantMatched:  []string{"identity 0 0 Allow"},
+			wantDecision: "allowed",
+			wantMissing:  []string{"aws:MultiFactorAuthPresent"},
+		},
+		"condition numeric and date": {
+			input: tfiam.PolicyEvaluationInput{
+				Action:           "s3:GetObject",
+				Resource:         objectARN,
+				Context:          map[string][]string{"s3:max-keys": {"5"}, "aws:CurrentTime": {"2024-06-01T12:00:00Z"}},
+				IdentityPolicies: []string{fmt.Sprintf(policyWithTests, `{"NumericLessThanEquals":{"s3:max-keys":10},"DateGreaterThan":{"aws:CurrentTime":"2024-01-01"},"DateLessThan":{"aws:CurrentTime":"1735689600"}}`)},
+			},
+			wantMatched:  []string{"identity 0 0 Allow"},
+			wantDecision: "allowed",
+		},
+		"condition ForAllValues": {
+			input: tfiam.PolicyEvaluationInput{
+				Action:           "s3:GetObject",
+				Resource:         objectARN,
+				Context:          map[string][]string{"aws:TagKeys": {"team", "Project"}},
+				IdentityPolicies: []string{fmt.Sprintf(policyWithTests, `{"ForAllValues:StringEqualsIgnoreCase":{"aws:TagKeys":["project","team","owner"]}}`)},
+			},
+			wantMatched:  []string{"identity 0 0 Allow"},
+			wantDecision: "allowed",
+		},
+		"condition ForAllValues not met": {
+			input: tfiam.PolicyEvaluationInput{
+				Action:           "s3:GetObject",
+				Resource:         objectARN,
+				Context:          map[string][]string{"aws:TagKeys": {"team", "cost-center"}},
+				IdentityPolicies: []string{fmt.Sprintf(policyWithTests, `{"ForAllValues:StringEquals":{"aws:TagKeys":["project","team"]}}`)},
+			},
+			wantDecision: "implicitDeny",
+		},
+		"condition ForAnyValue": {
+			input: tfiam.PolicyEvaluationInput{
+				Action:           "s3:GetObject",
+				Resource:         objectARN,
+				Context:          map[string][]string{"aws:TagKeys": {"team", "cost-center"}},
+				IdentityPolicies: []string{fmt.Sprintf(policyWithTests, `{"ForAnyValue:StringEquals":{"aws:TagKeys":["cost-center"]}}`)},
+			},
+			wantMatched:  []string{"identity 0 0 Allow"},
+			wantDecision: "allowed",
+		},
+		"condition ArnLike": {
+			input: tfiam.PolicyEvaluationInput{
+				Action:           "s3:GetObject",
+				Resource:         objectARN,
+				Context:          map[string][]string{"aws:SourceArn": {"arn:aws:sns:us-west-2:123456789012:topic"}},                     // lintignore:AWSAT003,AWSAT005
+				IdentityPolicies: []string{fmt.Sprintf(policyWithTests, `{"ArnLike":{"aws:SourceArn":"arn:aws:sns:*:123456789012:*"}}`)}, // lintignore:AWSAT005
+			},
+			wantMatched:  []string{"identity 0 0 Allow"},
+			wantDecision: "allowed",
+		},
+		"invalid condition operator": {
+			input: tfiam.PolicyEvaluationInput{
+				Action:           "s3:GetObject",
+				Resource:         objectARN,
+				IdentityPolicies: []string{fmt.Sprintf(policyWithTests, `{"StringEqual":{"aws:username":"jane"}}`)},
+			},
+			wantErr: true,
+		},
+		"invalid Effect": {
+			input: tfiam.PolicyEvaluationInput{
+				Action:           "s3:GetObject",
+				Resource:         objectARN,
+				IdentityPolicies: []string{`{"Version":"2012-10-17","Statement":[{"Effect":"allow","Action":"*","Resource":"*"}]}`},
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		testcase := testcase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := tfiam.EvaluatePolicies(&testcase.input)
+
+			if got, want := err != nil, testcase.wantErr; got != want {
+				t.Fatalf("expected error %t, got: %v", want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if got, want := result.Decision, testcase.wantDecision; got != want {
+				t.Errorf("expected decision %s, got %s", want, got)
+			}
+
+			matched := make([]string, 0, len(result.MatchedStatements))
+			for _, statement := range result.MatchedStatements {
+				matched = append(matched, fmt.Sprintf("%s %d %d %s", statement.PolicyType, statement.PolicyIndex, statement.StatementIndex, statement.Effect))
+			}
+			if got, want := strings.Join(matched, ", "), strings.Join(testcase.wantMatched, ", "); got != want {
+				t.Errorf("expected matched statements [%s], got [%s]", want, got)
+			}
+
+			if got, want := strings.Join(result.MissingContextKeys, ", "), strings.Join(testcase.wantMissing, ", "); got != want {
+				t.Errorf("expected missing context keys [%s], got [%s]", want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,352 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// Policy lint finding severities, in increasing order of severity.
+const (
+	policyLintSeverityWarning = "warning"
+	policyLintSeverityError   = "error"
+)
+
+// Policy lint finding codes.
+const (
+	policyLintCodeActionCasing               = "ACTION_CASING"
+	policyLintCodeActionMissingServicePrefix = "ACTION_MISSING_SERVICE_PREFIX"
+	policyLintCodeAllowNotActionAllResources = "ALLOW_NOT_ACTION_ALL_RESOURCES"
+	policyLintCodeDuplicateSid               = "DUPLICATE_SID"
+	policyLintCodeInvalidAction              = "INVALID_ACTION"
+	policyLintCodeInvalidAWSPrincipal        = "INVALID_AWS_PRINCIPAL"
+	policyLintCodeInvalidEffect              = "INVALID_EFFECT"
+	policyLintCodeInvalidJSON                = "INVALID_JSON"
+	policyLintCodePolicySizeExceeded         = "POLICY_SIZE_EXCEEDED"
+	policyLintCodeUnknownConditionOperator   = "UNKNOWN_CONDITION_OPERATOR"
+)
+
+// Policy types, which determine the maximum size of a policy.
+const (
+	policyTypeInlineGroup = "inline_group"
+	policyTypeInlineRole  = "inline_role"
+	policyTypeInlineUser  = "inline_user"
+	policyTypeManaged     = "managed"
+	policyTypeResource    = "resource"
+	policyTypeSCP         = "scp"
+)
+
+func policyTypes() []string {
+	return []string{
+		policyTypeInlineGroup,
+		policyTypeInlineRole,
+		policyTypeInlineUser,
+		policyTypeManaged,
+		policyTypeResource,
+		policyTypeSCP,
+	}
+}
+
+// policyMaxSizes are the maximum sizes of each type of policy, in characters.
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_iam-quotas.html#reference_iam-quotas-entity-length
+// https://docs.aws.amazon.com/organizations/latest/userguide/orgs_reference_limits.html
+var policyMaxSizes = map[string]int{
+	policyTypeInlineGroup: 5120,
+	policyTypeInlineRole:  10240,
+	policyTypeInlineUser:  2048,
+	policyTypeManaged:     6144,
+	policyTypeResource:    20480, // Amazon S3 bucket policies. Other services' limits may be lower.
+	policyTypeSCP:         5120,
+}
+
+// conditionOperators are the IAM condition operators, without the ForAllValues: and ForAnyValue: set operators
+// or the IfExists suffix.
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_condition_operators.html
+var conditionOperators = []string{
+	"ArnEquals",
+	"ArnLike",
+	"ArnNotEquals",
+	"ArnNotLike",
+	"BinaryEquals",
+	"Bool",
+	"DateEquals",
+	"DateGreaterThan",
+	"DateGreaterThanEquals",
+	"DateLessThan",
+	"DateLessThanEquals",
+	"DateNotEquals",
+	"IpAddress",
+	"NotIpAddress",
+	"Null",
+	"NumericEquals",
+	"NumericGreaterThan",
+	"NumericGreaterThanEquals",
+	"NumericLessThan",
+	"NumericLessThanEquals",
+	"NumericNotEquals",
+	"StringEquals",
+	"StringEqualsIgnoreCase",
+	"StringLike",
+	"StringNotEquals",
+	"StringNotEqualsIgnoreCase",
+	"StringNotLike",
+}
+
+type policyLintFinding struct {
+	Severity string
+	Code     string
+	Message  string
+	// Index of the statement that the finding applies to, or -1 if the finding applies to the whole policy.
+	StatementIndex int
+	Sid            string
+}
+
+// conditionOperator is a parsed IAM condition operator, e.g. "ForAnyValue:StringLikeIfExists".
+type conditionOperator struct {
+	Operator    string // e.g. "StringLike".
+	SetOperator string // "ForAllValues", "ForAnyValue" or "".
+	IfExists    bool
+}
+
+// parseConditionOperator parses an IAM condition operator. Operators are matched case-insensitively.
+func parseConditionOperator(s string) (conditionOperator, bool) {
+	var op conditionOperator
+
+	if setOperator, v, ok := strings.Cut(s, ":"); ok {
+		switch {
+		case strings.EqualFold(setOperator, "ForAllValues"):
+			op.SetOperator = "ForAllValues"
+		case strings.EqualFold(setOperator, "ForAnyValue"):
+			op.SetOperator = "ForAnyValue"
+		default:
+			return op, false
+		}
+		s = v
+	}
+
+	for _, operator := range conditionOperators {
+		if strings.EqualFold(s, operator) {
+			op.Operator = operator
+			return op, true
+		}
+
+		// Null can't be combined with IfExists.
+		if operator == "Null" {
+			continue
+		}
+
+		if strings.EqualFold(s, operator+"IfExists") {
+			op.Operator, op.IfExists = operator, true
+			return op, true
+		}
+	}
+
+	return op, false
+}
+
+// decodePolicyDocument decodes an IAM policy document, which may have a single statement object
+// in place of a list of statements.
+func decodePolicyDocument(policy string) (*IAMPolicyDoc, error) {
+	var raw struct {
+		Version   string          `json:",omitempty"`
+		Id        string          `json:",omitempty"`
+		Statement json.RawMessage `json:",omitempty"`
+	}
+
+	if err := json.Unmarshal([]byte(policy), &raw); err != nil {
+		return nil, err
+	}
+
+	doc := &IAMPolicyDoc{
+		Version: raw.Version,
+		Id:      raw.Id,
+	}
+
+	if statement := bytes.TrimSpace(raw.Statement); len(statement) > 0 && statement[0] == '{' {
+		var s IAMPolicyStatement
+		if err := json.Unmarshal(statement, &s); err != nil {
+			return nil, err
+		}
+		doc.Statements = []*IAMPolicyStatement{&s}
+	} else if len(statement) > 0 {
+		if err := json.Unmarshal(statement, &doc.Statements); err != nil {
+			return nil, err
+		}
+	}
+
+	return doc, nil
+}
+
+// policyStrings returns the values of a policy element that's a string or a list of strings.
+func policyStrings(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		var values []string
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				values = append(values, v)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+// policySize returns the size of a policy, in characters, as counted by AWS.
+// IAM doesn't count white space in identity-based policies.
+func policySize(policy, policyType string) int {
+	switch policyType {
+	case policyTypeResource, policyTypeSCP:
+		return utf8.RuneCountInString(policy)
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, []byte(policy)); err != nil {
+		return utf8.RuneCountInString(policy)
+	}
+
+	return utf8.RuneCount(buf.Bytes())
+}
+
+// lintPolicy statically checks an IAM policy document, returning the policy's size and any findings.
+func lintPolicy(policy, policyType string) (int, []policyLintFinding) {
+	var findings []policyLintFinding
+
+	add := func(severity, code string, statementIndex int, sid, format string, a ...any) {
+		findings = append(findings, policyLintFinding{
+			Severity:       severity,
+			Code:           code,
+			Message:        fmt.Sprintf(format, a...),
+			StatementIndex: statementIndex,
+			Sid:            sid,
+		})
+	}
+
+	size := policySize(policy, policyType)
+
+	if _, errs := verify.ValidIAMPolicyJSON(policy, "policy"); len(errs) > 0 {
+		for _, err := range errs {
+			add(policyLintSeverityError, policyLintCodeInvalidJSON, -1, "", "%s", err)
+		}
+		return size, findings
+	}
+
+	if maxSize, ok := policyMaxSizes[policyType]; ok && size > maxSize {
+		add(policyLintSeverityError, policyLintCodePolicySizeExceeded, -1, "", "policy is %d characters, which exceeds the %s policy limit of %d characters", size, policyType, maxSize)
+	}
+
+	doc, err := decodePolicyDocument(policy)
+	if err != nil {
+		add(policyLintSeverityError, policyLintCodeInvalidJSON, -1, "", "decoding policy: %s", err)
+		return size, findings
+	}
+
+	sids := make(map[string]int)
+
+	for i, statement := range doc.Statements {
+		if statement == nil {
+			continue
+		}
+
+		sid := statement.Sid
+
+		if sid != "" {
+			if j, ok := sids[sid]; ok {
+				add(policyLintSeverityError, policyLintCodeDuplicateSid, i, sid, "Sid (%s) is also used by statement %d", sid, j)
+			} else {
+				sids[sid] = i
+			}
+		}
+
+		if effect := statement.Effect; effect != "Allow" && effect != "Deny" {
+			add(policyLintSeverityError, policyLintCodeInvalidEffect, i, sid, "Effect (%s) must be Allow or Deny", effect)
+		}
+
+		for _, action := range slices.Concat(policyStrings(statement.Actions), policyStrings(statement.NotActions)) {
+			if action == "*" {
+				continue
+			}
+
+			service, name, ok := strings.Cut(action, ":")
+			if !ok {
+				add(policyLintSeverityError, policyLintCodeActionMissingServicePrefix, i, sid, "action (%s) has no service prefix, e.g. s3:%s", action, action)
+				continue
+			}
+
+			if !regexache.MustCompile(`^[0-9A-Za-z-]+$`).MatchString(service) || !regexache.MustCompile(`^[0-9A-Za-z*?]+$`).MatchString(name) {
+				add(policyLintSeverityError, policyLintCodeInvalidAction, i, sid, "action (%s) must be in the format service:ActionName, with optional * and ? wildcards", action)
+				continue
+			}
+
+			if service != strings.ToLower(service) {
+				add(policyLintSeverityWarning, policyLintCodeActionCasing, i, sid, "action (%s) service prefix should be lowercase", action)
+			}
+			if r, _ := utf8.DecodeRuneInString(name); unicode.IsLower(r) {
+				add(policyLintSeverityWarning, policyLintCodeActionCasing, i, sid, "action (%s) name should be PascalCase, e.g. GetObject", action)
+			}
+		}
+
+		if statement.Effect == "Allow" && statement.NotActions != nil && slices.Contains(policyStrings(statement.Resources), "*") {
+			add(policyLintSeverityWarning, policyLintCodeAllowNotActionAllResources, i, sid, "statement allows every action except those in NotAction, on all resources")
+		}
+
+		seen := make(map[string]bool)
+		for _, condition := range statement.Conditions {
+			if _, ok := parseConditionOperator(condition.Test); !ok && !seen[condition.Test] {
+				add(policyLintSeverityError, policyLintCodeUnknownConditionOperator, i, sid, "condition operator (%s) is not a valid IAM condition operator", condition.Test)
+			}
+			seen[condition.Test] = true
+		}
+
+		for _, principal := range slices.Concat(statement.Principals, statement.NotPrincipals) {
+			if principal.Type != "AWS" {
+				continue
+			}
+
+			for _, identifier := range policyStrings(principal.Identifiers) {
+				if !IsValidPolicyAWSPrincipal(identifier) {
+					add(policyLintSeverityError, policyLintCodeInvalidAWSPrincipal, i, sid, "AWS principal (%s) must be an ARN, an account ID or *", identifier)
+				}
+			}
+		}
+	}
+
+	// Principals and conditions are decoded from JSON objects, so sort findings for stable results.
+	slices.SortStableFunc(findings, func(a, b policyLintFinding) int {
+		return cmp.Or(cmp.Compare(a.StatementIndex, b.StatementIndex), cmp.Compare(a.Message, b.Message))
+	})
+
+	return size, findings
+}
+
+// policyLintSeverityAtLeast returns whether a finding's severity is at or above a threshold severity.
+func policyLintSeverityAtLeast(severity, threshold string) bool {
+	rank := func(severity string) int {
+		switch severity {
+		case policyLintSeverityWarning:
+			return 1
+		case policyLintSeverityError:
+			return 2
+		default:
+			return 0
+		}
+	}
+
+	return rank(threshold) > 0 && rank(severity) >= rank(threshold)
+}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	policyLintFailOnNone = "none"
+)
+
+// @SDKDataSource("aws_iam_policy_lint", name="Policy Lint")
+func dataSourcePolicyLint() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePolicyLintRead,
+
+		Schema: map[string]*schema.Schema{
+			"fail_on": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      policyLintFailOnNone,
+				ValidateFunc: validation.StringInSlice([]string{policyLintFailOnNone, policyLintSeverityWarning, policyLintSeverityError}, false),
+			},
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrMessage: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"statement_index": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"max_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrPolicy: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"policy_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      policyTypeManaged,
+				ValidateFunc: validation.StringInSlice(policyTypes(), false),
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePolicyLintRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	policy := d.Get(names.AttrPolicy).(string)
+	policyType := d.Get("policy_type").(string)
+	failOn := d.Get("fail_on").(string)
+
+	size, findings := lintPolicy(policy, policyType)
+
+	tfList := make([]interface{}, 0, len(findings))
+	for _, finding := range findings {
+		tfList = append(tfList, map[string]interface{}{
+			"code":            finding.Code,
+			names.AttrMessage: finding.Message,
+			"severity":        finding.Severity,
+			"sid":             finding.Sid,
+			"statement_index": finding.StatementIndex,
+		})
+
+		if policyLintSeverityAtLeast(finding.Severity, failOn) {
+			if finding.StatementIndex < 0 {
+				diags = sdkdiag.AppendErrorf(diags, "IAM Policy lint %s (%s): %s", finding.Severity, finding.Code, finding.Message)
+			} else {
+				diags = sdkdiag.AppendErrorf(diags, "IAM Policy lint %s (%s): statement %d: %s", finding.Severity, finding.Code, finding.StatementIndex, finding.Message)
+			}
+		}
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(policy)))
+	d.Set("findings", tfList)
+	d.Set("max_size", policyMaxSizes[policyType])
+	d.Set("size", size)
+
+	return diags
+}
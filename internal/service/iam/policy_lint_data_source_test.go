@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIAMPolicyLintDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_lint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyLintDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "findings.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.0.code", "ACTION_CASING"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.0.severity", "warning"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.0.statement_index", acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.1.code", "UNKNOWN_CONDITION_OPERATOR"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.1.severity", "error"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.1.sid", "Read"),
+					resource.TestCheckResourceAttr(dataSourceName, "max_size", "6144"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "size"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyLintDataSource_failOn(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyLintDataSourceConfig_failOn("error"),
+				ExpectError: regexache.MustCompile(`IAM Policy lint error \(DUPLICATE_SID\): statement 1`),
+			},
+			{
+				Config: testAccPolicyLintDataSourceConfig_failOn("none"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_iam_policy_lint.test", "findings.#", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+const testAccPolicyLintDataSourceConfig_basic = `
+data "aws_iam_policy_lint" "test" {
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid      = "Read"
+      Effect   = "Allow"
+      Action   = ["s3:getobject*"]
+      Resource = "*"
+      Condition = {
+        StringEqual = {
+          "aws:PrincipalTag/team" = "data"
+        }
+      }
+    }]
+  })
+}
+`
+
+func testAccPolicyLintDataSourceConfig_failOn(failOn string) string {
+	return `
+data "aws_iam_policy_lint" "test" {
+  policy_type = "scp"
+  fail_on     = "` + failOn + `"
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Sid      = "DenyRegions"
+        Effect   = "Deny"
+        Action   = "*"
+        Resource = "*"
+      },
+      {
+        Sid      = "DenyRegions"
+        Effect   = "Deny"
+        Action   = "ec2:RunInstances"
+        Resource = "*"
+      },
+    ]
+  })
+}
+`
+}
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func TestLintPolicy(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		policy     string
+		policyType string
+		// Expected findings, as "severity code statement_index".
+		want []string
+	}{
+		"valid": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "ReadObjects",
+      "Effect": "Allow",
+      "Action": ["s3:GetObject", "s3:List*"],
+      "Resource": "arn:aws:s3:::example/*",
+      "Condition": {
+        "ForAnyValue:StringLikeIfExists": {"aws:PrincipalTag/team": ["a*"]},
+        "NumericLessThanEquals": {"s3:max-keys": 10}
+      }
+    }
+  ]
+}`, // lintignore:AWSAT005
+			policyType: "managed",
+		},
+		"single statement object": {
+			policy:     `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:getobject*","Resource":"*"}}`,
+			policyType: "managed",
+			want:       []string{"warning ACTION_CASING 0"},
+		},
+		"invalid JSON": {
+			policy:     `{"Version":"2012-10-17",`,
+			policyType: "managed",
+			want:       []string{"error INVALID_JSON -1"},
+		},
+		"malformed actions": {
+			policy:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["GetObject","S3:GetObject","s3:Get Object","*"],"Resource":"*"}]}`,
+			policyType: "managed",
+			want: []string{
+				"error ACTION_MISSING_SERVICE_PREFIX 0",
+				"warning ACTION_CASING 0",
+				"error INVALID_ACTION 0",
+			},
+		},
+		"allow not action all resources": {
+			policy:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","NotAction":"iam:*","Resource":"*"},{"Effect":"Deny","NotAction":"iam:*","Resource":"*"}]}`,
+			policyType: "managed",
+			want:       []string{"warning ALLOW_NOT_ACTION_ALL_RESOURCES 0"},
+		},
+		"duplicate Sid and invalid Effect": {
+			policy:     `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Sid":"A","Effect":"allow","Action":"s3:PutObject","Resource":"*"}]}`,
+			policyType: "managed",
+			want: []string{
+				"error INVALID_EFFECT 1",
+				"error DUPLICATE_SID 1",
+			},
+		},
+		"unknown condition operator": {
+			policy:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEqual":{"aws:PrincipalTag/team":"a"},"NullIfExists":{"aws:TokenIssueTime":"true"}}}]}`,
+			policyType: "managed",
+			want: []string{
+				"error UNKNOWN_CONDITION_OPERATOR 0",
+				"error UNKNOWN_CONDITION_OPERATOR 0",
+			},
+		},
+		"invalid AWS principal": {
+			policy:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["123456789012","AROAS5MHDZS6NEXAMPLE"],"Service":"lambda.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			policyType: "resource",
+			want:       []string{"error INVALID_AWS_PRINCIPAL 0"},
+		},
+		"size exceeded": {
+			policy:     fmt.Sprintf(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::%s"}]}`, strings.Repeat("a", 2048)), // lintignore:AWSAT005
+			policyType: "inline_user",
+			want:       []string{"error POLICY_SIZE_EXCEEDED -1"},
+		},
+		"size white space": {
+			policy:     fmt.Sprintf(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"%s}]}`, strings.Repeat(" ", 6144)),
+			policyType: "managed",
+		},
+	}
+
+	for name, testcase := range testcases {
+		testcase := testcase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, findings := tfiam.LintPolicy(testcase.policy, testcase.policyType)
+
+			got := make([]string, 0, len(findings))
+			for _, finding := range findings {
+				got = append(got, fmt.Sprintf("%s %s %d", finding.Severity, finding.Code, finding.StatementIndex))
+			}
+
+			if a, e := strings.Join(got, ", "), strings.Join(testcase.want, ", "); a != e {
+				t.Fatalf("expected findings [%s], got [%s]: %#v", e, a, findings)
+			}
+		})
+	}
+}
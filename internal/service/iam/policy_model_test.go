@@ -431,3 +431,61 @@ func TestPolicyUnmarshalServicePrincipalOrder(t *testing.T) {
 		t.Fatalf("should be equal, but was:\n%#v\nVS\n%#v\n", data1, data2)
 	}
 }
+
+func TestIAMPolicyStatementConditionSet_UnmarshalJSON(t *testing.T) { // nosemgrep:ci.iam-in-func-name
+	t.Parallel()
+
+	testcases := map[string]struct {
+		json    string
+		want    tfiam.IAMPolicyStatementConditionSet
+		wantErr bool
+	}{
+		"string": {
+			json: `{"StringLike":{"s3:prefix":"one/"}}`,
+			want: tfiam.IAMPolicyStatementConditionSet{
+				{Test: "StringLike", Variable: "s3:prefix", Values: []string{"one/"}},
+			},
+		},
+		"number": {
+			json: `{"NumericLessThanEquals":{"s3:max-keys":10}}`,
+			want: tfiam.IAMPolicyStatementConditionSet{
+				{Test: "NumericLessThanEquals", Variable: "s3:max-keys", Values: "10"},
+			},
+		},
+		"mixed list": {
+			json: `{"NumericEquals":{"s3:max-keys":["5",10,true]}}`,
+			want: tfiam.IAMPolicyStatementConditionSet{
+				{Test: "NumericEquals", Variable: "s3:max-keys", Values: []string{"5", "10", "true"}},
+			},
+		},
+		"invalid list element": {
+			json:    `{"StringEquals":{"aws:PrincipalTag/team":[{"name":"a"}]}}`,
+			wantErr: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		testcase := testcase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got tfiam.IAMPolicyStatementConditionSet
+			err := json.Unmarshal([]byte(testcase.json), &got)
+
+			if testcase.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testcase.want) {
+				t.Fatalf("expected %#v, got %#v", testcase.want, got)
+			}
+		})
+	}
+}
@@ -260,7 +260,7 @@ func waitServiceLinkedRoleDeleted(ctx context.Context, conn *iam.Client, id stri
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*iam.GetServiceLinkedRoleDeletionStatusOutput); ok {
 		if reason := output.Reason; reason != nil {
@@ -66,6 +66,16 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_iam_policy_document",
 			Name:     "Policy Document",
 		},
+		{
+			Factory:  dataSourcePolicyEvaluation,
+			TypeName: "aws_iam_policy_evaluation",
+			Name:     "Policy Evaluation",
+		},
+		{
+			Factory:  dataSourcePolicyLint,
+			TypeName: "aws_iam_policy_lint",
+			Name:     "Policy Lint",
+		},
 		{
 			Factory:  dataSourcePrincipalPolicySimulation,
 			TypeName: "aws_iam_principal_policy_simulation",
@@ -414,7 +414,7 @@ func waitEnabled(ctx context.Context, conn *inspector2.Client, accountIDs []stri
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(map[string]AccountResourceStatus); ok {
 		return output, err
@@ -432,7 +432,7 @@ func waitDisabled(ctx context.Context, conn *inspector2.Client, accountIDs []str
 		Delay:   10 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 	return err
 }
 
@@ -214,7 +214,7 @@ func waitOrganizationConfigurationUpdated(ctx context.Context, conn *inspector2.
 		MinTimeout:                time.Second * 5,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
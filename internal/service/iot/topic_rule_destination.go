@@ -300,7 +300,7 @@ func waitTopicRuleDestinationCreated(ctx context.Context, conn *iot.Client, arn
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TopicRuleDestination); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
@@ -319,7 +319,7 @@ func waitTopicRuleDestinationDeleted(ctx context.Context, conn *iot.Client, arn
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TopicRuleDestination); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
@@ -338,7 +338,7 @@ func waitTopicRuleDestinationDisabled(ctx context.Context, conn *iot.Client, arn
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TopicRuleDestination); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
@@ -357,7 +357,7 @@ func waitTopicRuleDestinationEnabled(ctx context.Context, conn *iot.Client, arn
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TopicRuleDestination); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
@@ -254,7 +254,7 @@ func waitConfigurationDeleted(ctx context.Context, conn *kafka.Client, arn strin
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*kafka.DescribeConfigurationOutput); ok {
 		return output, err
@@ -389,7 +389,7 @@ func waitReplicatorCreated(ctx context.Context, conn *kafka.Client, arn string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if output, ok := outputRaw.(*kafka.DescribeReplicatorOutput); ok {
 		if stateInfo := output.StateInfo; stateInfo != nil {
 			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.ToString(stateInfo.Code), aws.ToString(stateInfo.Message)))
@@ -409,7 +409,7 @@ func waitReplicatorUpdated(ctx context.Context, conn *kafka.Client, arn string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if output, ok := outputRaw.(*kafka.DescribeReplicatorOutput); ok {
 		if stateInfo := output.StateInfo; stateInfo != nil {
 			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.ToString(stateInfo.Code), aws.ToString(stateInfo.Message)))
@@ -429,7 +429,7 @@ func waitReplicatorDeleted(ctx context.Context, conn *kafka.Client, arn string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if output, ok := outputRaw.(*kafka.DescribeReplicatorOutput); ok {
 		if stateInfo := output.StateInfo; stateInfo != nil {
 			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.ToString(stateInfo.Code), aws.ToString(stateInfo.Message)))
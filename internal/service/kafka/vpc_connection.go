@@ -176,7 +176,7 @@ func waitVPCConnectionCreated(ctx context.Context, conn *kafka.Client, id string
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if output, ok := outputRaw.(*kafka.DescribeVpcConnectionOutput); ok {
 		return output, err
 	}
@@ -192,7 +192,7 @@ func waitVPCConnectionDeleted(ctx context.Context, conn *kafka.Client, arn strin
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if output, ok := outputRaw.(*kafka.DescribeVpcConnectionOutput); ok {
 		return output, err
 	}
@@ -221,7 +221,7 @@ func waitWorkerConfigurationDeleted(ctx context.Context, conn *kafkaconnect.Clie
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*kafkaconnect.DescribeWorkerConfigurationOutput); ok {
 		return output, err
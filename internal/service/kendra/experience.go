@@ -354,7 +354,7 @@ func waitExperienceCreated(ctx context.Context, conn *kendra.Client, id, indexId
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*kendra.DescribeExperienceOutput); ok {
 		if out.Status == types.ExperienceStatusFailed {
 			tfresource.SetLastError(err, errors.New(aws.ToString(out.ErrorMessage)))
@@ -374,7 +374,7 @@ func waitExperienceUpdated(ctx context.Context, conn *kendra.Client, id, indexId
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*kendra.DescribeExperienceOutput); ok {
 		if out.Status == types.ExperienceStatusFailed {
 			tfresource.SetLastError(err, errors.New(aws.ToString(out.ErrorMessage)))
@@ -392,7 +392,7 @@ func waitExperienceDeleted(ctx context.Context, conn *kendra.Client, id, indexId
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*kendra.DescribeExperienceOutput); ok {
 		if out.Status == types.ExperienceStatusFailed {
 			tfresource.SetLastError(err, errors.New(aws.ToString(out.ErrorMessage)))
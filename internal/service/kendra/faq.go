@@ -311,7 +311,7 @@ func waitFaqCreated(ctx context.Context, conn *kendra.Client, id, indexId string
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*kendra.DescribeFaqOutput); ok {
 		if output.Status == types.FaqStatusFailed {
@@ -331,7 +331,7 @@ func waitFaqDeleted(ctx context.Context, conn *kendra.Client, id, indexId string
 		Refresh: statusFaq(ctx, conn, id, indexId),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*kendra.DescribeFaqOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.ErrorMessage)))
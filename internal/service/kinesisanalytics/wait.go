@@ -26,7 +26,7 @@ func waitApplicationDeleted(ctx context.Context, conn *kinesisanalytics.Client,
 		Timeout: applicationDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*awstypes.ApplicationDetail); ok {
 		return v, err
@@ -46,7 +46,7 @@ func waitApplicationStarted(ctx context.Context, conn *kinesisanalytics.Client,
 		Timeout: applicationStartedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*awstypes.ApplicationDetail); ok {
 		return v, err
@@ -66,7 +66,7 @@ func waitApplicationStopped(ctx context.Context, conn *kinesisanalytics.Client,
 		Timeout: applicationStoppedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*awstypes.ApplicationDetail); ok {
 		return v, err
@@ -86,7 +86,7 @@ func waitApplicationUpdated(ctx context.Context, conn *kinesisanalytics.Client,
 		Timeout: applicationUpdatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*awstypes.ApplicationDetail); ok {
 		return v, err
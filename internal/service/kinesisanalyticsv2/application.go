@@ -1607,7 +1607,7 @@ func waitApplicationStarted(ctx context.Context, conn *kinesisanalyticsv2.Client
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ApplicationDetail); ok {
 		return output, err
@@ -1624,7 +1624,7 @@ func waitApplicationStopped(ctx context.Context, conn *kinesisanalyticsv2.Client
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ApplicationDetail); ok {
 		return output, err
@@ -1641,7 +1641,7 @@ func waitApplicationUpdated(ctx context.Context, conn *kinesisanalyticsv2.Client
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ApplicationDetail); ok {
 		return output, err
@@ -1658,7 +1658,7 @@ func waitApplicationDeleted(ctx context.Context, conn *kinesisanalyticsv2.Client
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ApplicationDetail); ok {
 		return output, err
@@ -1721,7 +1721,7 @@ func waitApplicationOperationSucceeded(ctx context.Context, conn *kinesisanalyti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ApplicationOperationInfoDetails); ok {
 		if failureDetails := output.OperationFailureDetails; failureDetails != nil && failureDetails.ErrorInfo != nil {
@@ -255,7 +255,7 @@ func waitSnapshotCreated(ctx context.Context, conn *kinesisanalyticsv2.Client, a
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SnapshotDetails); ok {
 		return output, err
@@ -272,7 +272,7 @@ func waitSnapshotDeleted(ctx context.Context, conn *kinesisanalyticsv2.Client, a
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.SnapshotDetails); ok {
 		return output, err
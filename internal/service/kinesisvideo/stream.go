@@ -273,7 +273,7 @@ func waitStreamCreated(ctx context.Context, conn *kinesisvideo.Client, arn strin
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.StreamInfo); ok {
 		return output, err
@@ -292,7 +292,7 @@ func waitStreamUpdated(ctx context.Context, conn *kinesisvideo.Client, arn strin
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.StreamInfo); ok {
 		return output, err
@@ -311,7 +311,7 @@ func waitStreamDeleted(ctx context.Context, conn *kinesisvideo.Client, arn strin
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.StreamInfo); ok {
 		return output, err
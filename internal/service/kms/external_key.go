@@ -426,7 +426,7 @@ func waitKeyMaterialImported(ctx context.Context, conn *kms.Client, id string) (
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.KeyMetadata); ok {
 		return output, err
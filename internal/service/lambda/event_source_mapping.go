@@ -818,7 +818,7 @@ func waitEventSourceMappingCreated(ctx context.Context, conn *lambda.Client, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*lambda.GetEventSourceMappingOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateTransitionReason)))
@@ -840,7 +840,7 @@ func waitEventSourceMappingUpdated(ctx context.Context, conn *lambda.Client, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*lambda.GetEventSourceMappingOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateTransitionReason)))
@@ -862,7 +862,7 @@ func waitEventSourceMappingDeleted(ctx context.Context, conn *lambda.Client, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*lambda.GetEventSourceMappingOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateTransitionReason)))
@@ -1231,7 +1231,7 @@ func waitFunctionCreated(ctx context.Context, conn *lambda.Client, name string,
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.FunctionConfiguration); ok {
 		tfresource.SetLastError(err, fmt.Errorf("%s: %s", string(output.StateReasonCode), aws.ToString(output.StateReason)))
@@ -1251,7 +1251,7 @@ func waitFunctionUpdated(ctx context.Context, conn *lambda.Client, functionName
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.FunctionConfiguration); ok {
 		tfresource.SetLastError(err, fmt.Errorf("%s: %s", string(output.LastUpdateStatusReasonCode), aws.ToString(output.LastUpdateStatusReason)))
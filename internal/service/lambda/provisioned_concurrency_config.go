@@ -258,7 +258,7 @@ func waitProvisionedConcurrencyConfigReady(ctx context.Context, conn *lambda.Cli
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*lambda.GetProvisionedConcurrencyConfigOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
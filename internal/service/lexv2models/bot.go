@@ -411,7 +411,7 @@ func waitBotCreated(ctx context.Context, conn *lexmodelsv2.Client, id string, ti
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*lexmodelsv2.DescribeBotOutput); ok {
 		return out, err
 	}
@@ -429,7 +429,7 @@ func waitBotUpdated(ctx context.Context, conn *lexmodelsv2.Client, id string, ti
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*lexmodelsv2.DescribeBotOutput); ok {
 		return out, err
 	}
@@ -445,7 +445,7 @@ func waitBotDeleted(ctx context.Context, conn *lexmodelsv2.Client, id string, ti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*lexmodelsv2.DescribeBotOutput); ok {
 		return out, err
 	}
@@ -1163,7 +1163,7 @@ func waitIntentNormal(ctx context.Context, conn *lexmodelsv2.Client, intentID, b
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*lexmodelsv2.DescribeIntentOutput); ok {
 		return out, err
 	}
@@ -1180,7 +1180,7 @@ func waitIntentDeleted(ctx context.Context, conn *lexmodelsv2.Client, intentID,
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*lexmodelsv2.DescribeIntentOutput); ok {
 		return out, err
 	}
@@ -472,7 +472,7 @@ func waitApplicationCreated(ctx context.Context, conn *m2.Client, id string, tim
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*m2.GetApplicationOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
@@ -491,7 +491,7 @@ func waitApplicationUpdated(ctx context.Context, conn *m2.Client, id string, ver
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*m2.GetApplicationVersionOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
@@ -510,7 +510,7 @@ func waitApplicationDeleted(ctx context.Context, conn *m2.Client, id string, tim
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*m2.GetApplicationOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
@@ -529,7 +529,7 @@ func waitApplicationDeletedFromEnvironment(ctx context.Context, conn *m2.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*m2.GetApplicationOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
@@ -549,7 +549,7 @@ func waitApplicationStopped(ctx context.Context, conn *m2.Client, id string, tim
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*m2.GetApplicationOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
@@ -569,7 +569,7 @@ func waitApplicationRunning(ctx context.Context, conn *m2.Client, id string, tim
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*m2.GetApplicationOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
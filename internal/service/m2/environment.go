@@ -508,7 +508,7 @@ func waitEnvironmentCreated(ctx context.Context, conn *m2.Client, id string, tim
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*m2.GetEnvironmentOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
@@ -527,7 +527,7 @@ func waitEnvironmentUpdated(ctx context.Context, conn *m2.Client, id string, tim
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*m2.GetEnvironmentOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
@@ -548,7 +548,7 @@ func waitEnvironmentDeleted(ctx context.Context, conn *m2.Client, id string, tim
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*m2.GetEnvironmentOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
@@ -388,7 +388,7 @@ func waitInputCreated(ctx context.Context, conn *medialive.Client, id string, ti
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeInputOutput); ok {
 		return out, err
 	}
@@ -407,7 +407,7 @@ func waitInputUpdated(ctx context.Context, conn *medialive.Client, id string, ti
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeInputOutput); ok {
 		return out, err
 	}
@@ -423,7 +423,7 @@ func waitInputDeleted(ctx context.Context, conn *medialive.Client, id string, ti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*medialive.DescribeInputOutput); ok {
 		return out, err
 	}
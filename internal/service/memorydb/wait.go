@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/service/memorydb"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -38,7 +39,7 @@ func waitACLActive(ctx context.Context, conn *memorydb.Client, aclId string) err
 		Timeout: aclActiveTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -52,7 +53,7 @@ func waitACLDeleted(ctx context.Context, conn *memorydb.Client, aclId string) er
 		Timeout: aclDeletedTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -66,7 +67,7 @@ func waitClusterAvailable(ctx context.Context, conn *memorydb.Client, clusterId
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -80,7 +81,7 @@ func waitClusterDeleted(ctx context.Context, conn *memorydb.Client, clusterId st
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -95,7 +96,7 @@ func waitClusterParameterGroupInSync(ctx context.Context, conn *memorydb.Client,
 		Timeout: clusterParameterGroupInSyncTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -110,7 +111,7 @@ func waitClusterSecurityGroupsActive(ctx context.Context, conn *memorydb.Client,
 		Timeout: clusterSecurityGroupsActiveTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -124,7 +125,7 @@ func waitUserActive(ctx context.Context, conn *memorydb.Client, userId string) e
 		Timeout: userActiveTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -138,7 +139,7 @@ func waitUserDeleted(ctx context.Context, conn *memorydb.Client, userId string)
 		Timeout: userDeletedTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -152,7 +153,7 @@ func waitSnapshotAvailable(ctx context.Context, conn *memorydb.Client, snapshotI
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -166,7 +167,7 @@ func waitSnapshotDeleted(ctx context.Context, conn *memorydb.Client, snapshotId
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -938,7 +938,7 @@ func waitDBClusterAvailable(ctx context.Context, conn *neptune.Neptune, id strin
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*neptune.DBCluster); ok {
 		return output, err
@@ -962,7 +962,7 @@ func waitDBClusterDeleted(ctx context.Context, conn *neptune.Neptune, id string,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*neptune.DBCluster); ok {
 		return output, err
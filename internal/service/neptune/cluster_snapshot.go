@@ -270,7 +270,7 @@ func waitClusterSnapshotCreated(ctx context.Context, conn *neptune.Neptune, id s
 		Delay:      5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*neptune.DBClusterSnapshot); ok {
 		return output, err
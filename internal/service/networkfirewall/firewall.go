@@ -479,7 +479,7 @@ func waitFirewallCreated(ctx context.Context, conn *networkfirewall.Client, time
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkfirewall.DescribeFirewallOutput); ok {
 		return output, err
@@ -500,7 +500,7 @@ func waitFirewallUpdated(ctx context.Context, conn *networkfirewall.Client, time
 		Delay: 30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkfirewall.DescribeFirewallOutput); ok {
 		return output, err
@@ -517,7 +517,7 @@ func waitFirewallDeleted(ctx context.Context, conn *networkfirewall.Client, time
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkfirewall.DescribeFirewallOutput); ok {
 		return output, err
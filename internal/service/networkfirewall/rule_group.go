@@ -668,7 +668,7 @@ func waitRuleGroupDeleted(ctx context.Context, conn *networkfirewall.Client, arn
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkfirewall.DescribeRuleGroupOutput); ok {
 		return output, err
@@ -444,7 +444,7 @@ func waitConnectPeerCreated(ctx context.Context, conn *networkmanager.NetworkMan
 		Refresh: statusConnectPeerState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.ConnectPeer); ok {
 		return output, err
@@ -462,7 +462,7 @@ func waitConnectPeerDeleted(ctx context.Context, conn *networkmanager.NetworkMan
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateconf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateconf)
 
 	if output, ok := outputRaw.(*networkmanager.ConnectPeer); ok {
 		return output, err
@@ -331,7 +331,7 @@ func waitConnectionCreated(ctx context.Context, conn *networkmanager.NetworkMana
 		Refresh: statusConnectionState(ctx, conn, globalNetworkID, connectionID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.Connection); ok {
 		return output, err
@@ -348,7 +348,7 @@ func waitConnectionDeleted(ctx context.Context, conn *networkmanager.NetworkMana
 		Refresh: statusConnectionState(ctx, conn, globalNetworkID, connectionID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.Connection); ok {
 		return output, err
@@ -365,7 +365,7 @@ func waitConnectionUpdated(ctx context.Context, conn *networkmanager.NetworkMana
 		Refresh: statusConnectionState(ctx, conn, globalNetworkID, connectionID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.Connection); ok {
 		return output, err
@@ -300,7 +300,7 @@ func waitCustomerGatewayAssociationCreated(ctx context.Context, conn *networkman
 		Refresh: statusCustomerGatewayAssociationState(ctx, conn, globalNetworkID, customerGatewayARN),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.CustomerGatewayAssociation); ok {
 		return output, err
@@ -317,7 +317,7 @@ func waitCustomerGatewayAssociationDeleted(ctx context.Context, conn *networkman
 		Refresh: statusCustomerGatewayAssociationState(ctx, conn, globalNetworkID, customerGatewayARN),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.CustomerGatewayAssociation); ok {
 		return output, err
@@ -371,7 +371,7 @@ func waitGlobalNetworkCreated(ctx context.Context, conn *networkmanager.NetworkM
 		Refresh: statusGlobalNetworkState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.GlobalNetwork); ok {
 		return output, err
@@ -389,7 +389,7 @@ func waitGlobalNetworkDeleted(ctx context.Context, conn *networkmanager.NetworkM
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.GlobalNetwork); ok {
 		return output, err
@@ -406,7 +406,7 @@ func waitGlobalNetworkUpdated(ctx context.Context, conn *networkmanager.NetworkM
 		Refresh: statusGlobalNetworkState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.GlobalNetwork); ok {
 		return output, err
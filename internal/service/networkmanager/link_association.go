@@ -256,7 +256,7 @@ func waitLinkAssociationCreated(ctx context.Context, conn *networkmanager.Networ
 		Refresh: statusLinkAssociationState(ctx, conn, globalNetworkID, linkID, deviceID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.LinkAssociation); ok {
 		return output, err
@@ -273,7 +273,7 @@ func waitLinkAssociationDeleted(ctx context.Context, conn *networkmanager.Networ
 		Refresh: statusLinkAssociationState(ctx, conn, globalNetworkID, linkID, deviceID),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.LinkAssociation); ok {
 		return output, err
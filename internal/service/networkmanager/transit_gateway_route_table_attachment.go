@@ -252,7 +252,7 @@ func waitTransitGatewayRouteTableAttachmentCreated(ctx context.Context, conn *ne
 		Refresh: statusTransitGatewayRouteTableAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.TransitGatewayRouteTableAttachment); ok {
 		return output, err
@@ -270,7 +270,7 @@ func waitTransitGatewayRouteTableAttachmentDeleted(ctx context.Context, conn *ne
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.TransitGatewayRouteTableAttachment); ok {
 		return output, err
@@ -287,7 +287,7 @@ func waitTransitGatewayRouteTableAttachmentAvailable(ctx context.Context, conn *
 		Refresh: statusTransitGatewayRouteTableAttachmentState(ctx, conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmanager.TransitGatewayRouteTableAttachment); ok {
 		return output, err
@@ -280,7 +280,7 @@ func waitMonitorReady(ctx context.Context, conn *networkmonitor.Client, name str
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmonitor.GetMonitorOutput); ok {
 		return output, err
@@ -301,7 +301,7 @@ func waitMonitorDeleted(ctx context.Context, conn *networkmonitor.Client, name s
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmonitor.GetMonitorOutput); ok {
 		return output, err
@@ -363,7 +363,7 @@ func waitProbeReady(ctx context.Context, conn *networkmonitor.Client, monitorNam
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmonitor.GetProbeOutput); ok {
 		return output, err
@@ -384,7 +384,7 @@ func waitProbeDeleted(ctx context.Context, conn *networkmonitor.Client, monitorN
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*networkmonitor.GetProbeOutput); ok {
 		return output, err
@@ -229,7 +229,7 @@ func waitInboundConnectionAccepted(ctx context.Context, conn *opensearchservice.
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*opensearchservice.InboundConnection); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ConnectionStatus.Message)))
@@ -248,7 +248,7 @@ func waitInboundConnectionRejected(ctx context.Context, conn *opensearchservice.
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*opensearchservice.InboundConnection); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ConnectionStatus.Message)))
@@ -267,7 +267,7 @@ func waitInboundConnectionDeleted(ctx context.Context, conn *opensearchservice.O
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*opensearchservice.InboundConnection); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.ConnectionStatus.Message)))
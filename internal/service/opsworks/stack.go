@@ -207,6 +207,11 @@ func resourceStackCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	name := d.Get(names.AttrName).(string)
 	region := d.Get(names.AttrRegion).(string)
+
+	if err := meta.(*conns.AWSClient).VerifyRegionAllowed(ctx, region); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	input := &opsworks.CreateStackInput{
 		ChefConfiguration: &opsworks.ChefConfiguration{
 			ManageBerkshelf: aws.Bool(d.Get("manage_berkshelf").(bool)),
@@ -324,7 +329,10 @@ func resourceStackRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	conn := meta.(*conns.AWSClient).OpsWorksConn(ctx)
 
 	if v, ok := d.GetOk("stack_endpoint"); ok {
-		conn = meta.(*conns.AWSClient).OpsWorksConnForRegion(ctx, v.(string))
+		conn, err = meta.(*conns.AWSClient).OpsWorksConnForRegion(ctx, v.(string))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 	}
 
 	stack, err := FindStackByID(ctx, conn, d.Id())
@@ -333,9 +341,11 @@ func resourceStackRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		// If it's not found in the default region we're in, we check us-east-1
 		// in the event this stack was created with Terraform before version 0.9.
 		// See https://github.com/hashicorp/terraform/issues/12842.
-		conn = meta.(*conns.AWSClient).OpsWorksConnForRegion(ctx, names.USEast1RegionID)
-
-		stack, err = FindStackByID(ctx, conn, d.Id())
+		// The fallback is skipped if us-east-1 is not allowed by the provider configuration.
+		if c, errConn := meta.(*conns.AWSClient).OpsWorksConnForRegion(ctx, names.USEast1RegionID); errConn == nil {
+			conn = c
+			stack, err = FindStackByID(ctx, conn, d.Id())
+		}
 	}
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -421,7 +431,10 @@ func resourceStackUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	conn := meta.(*conns.AWSClient).OpsWorksConn(ctx)
 
 	if v, ok := d.GetOk("stack_endpoint"); ok {
-		conn = meta.(*conns.AWSClient).OpsWorksConnForRegion(ctx, v.(string))
+		conn, err = meta.(*conns.AWSClient).OpsWorksConnForRegion(ctx, v.(string))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 	}
 
 	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
@@ -534,7 +547,10 @@ func resourceStackDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	conn := meta.(*conns.AWSClient).OpsWorksConn(ctx)
 
 	if v, ok := d.GetOk("stack_endpoint"); ok {
-		conn = meta.(*conns.AWSClient).OpsWorksConnForRegion(ctx, v.(string))
+		conn, err = meta.(*conns.AWSClient).OpsWorksConnForRegion(ctx, v.(string))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 	}
 
 	log.Printf("[DEBUG] Deleting OpsWorks Stack: %s", d.Id())
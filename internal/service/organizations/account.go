@@ -440,7 +440,7 @@ func waitAccountCreated(ctx context.Context, conn *organizations.Client, id stri
 		Timeout:      5 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.CreateAccountStatus); ok {
 		if state := output.State; state == awstypes.CreateAccountStateFailed {
@@ -478,7 +478,7 @@ func waitAccountDeleted(ctx context.Context, conn *organizations.Client, id stri
 		Timeout:      5 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Account); ok {
 		return output, err
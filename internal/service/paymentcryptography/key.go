@@ -432,7 +432,7 @@ func waitKeyCreated(ctx context.Context, conn *paymentcryptography.Client, id st
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.Key); ok {
 		return out, err
 	}
@@ -448,7 +448,7 @@ func waitKeyDeleted(ctx context.Context, conn *paymentcryptography.Client, id st
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.Key); ok {
 		return out, err
 	}
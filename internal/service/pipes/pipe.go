@@ -373,7 +373,7 @@ func waitPipeCreated(ctx context.Context, conn *pipes.Client, id string, timeout
 		ContinuousTargetOccurence: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if output, ok := outputRaw.(*pipes.DescribePipeOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateReason)))
 
@@ -393,7 +393,7 @@ func waitPipeUpdated(ctx context.Context, conn *pipes.Client, id string, timeout
 		ContinuousTargetOccurence: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if output, ok := outputRaw.(*pipes.DescribePipeOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateReason)))
 
@@ -411,7 +411,7 @@ func waitPipeDeleted(ctx context.Context, conn *pipes.Client, id string, timeout
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if output, ok := outputRaw.(*pipes.DescribePipeOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateReason)))
 
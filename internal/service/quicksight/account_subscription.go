@@ -298,7 +298,7 @@ func waitAccountSubscriptionCreated(ctx context.Context, conn *quicksight.QuickS
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*quicksight.AccountInfo); ok {
 		return out, err
 	}
@@ -314,7 +314,7 @@ func waitAccountSubscriptionDeleted(ctx context.Context, conn *quicksight.QuickS
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*quicksight.AccountInfo); ok {
 		return out, err
 	}
@@ -31,7 +31,7 @@ func waitCreated(ctx context.Context, conn *quicksight.QuickSight, accountId, da
 		Timeout: dataSourceCreateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*quicksight.DataSource); ok {
 		if status, errorInfo := aws.StringValue(output.Status), output.ErrorInfo; status == quicksight.ResourceStatusCreationFailed && errorInfo != nil {
@@ -53,7 +53,7 @@ func waitUpdated(ctx context.Context, conn *quicksight.QuickSight, accountId, da
 		Timeout: dataSourceUpdateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*quicksight.DataSource); ok {
 		if status, errorInfo := aws.StringValue(output.Status), output.ErrorInfo; status == quicksight.ResourceStatusUpdateFailed && errorInfo != nil {
@@ -76,7 +76,7 @@ func waitTemplateCreated(ctx context.Context, conn *quicksight.QuickSight, id st
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*quicksight.Template); ok {
 		if status, apiErrors := aws.StringValue(out.Version.Status), out.Version.Errors; status == quicksight.ResourceStatusCreationFailed && apiErrors != nil {
 			var errs []error
@@ -107,7 +107,7 @@ func waitTemplateUpdated(ctx context.Context, conn *quicksight.QuickSight, id st
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*quicksight.Template); ok {
 		if status, apiErrors := aws.StringValue(out.Version.Status), out.Version.Errors; status == quicksight.ResourceStatusCreationFailed && apiErrors != nil {
 			var errs []error
@@ -139,7 +139,7 @@ func waitDashboardCreated(ctx context.Context, conn *quicksight.QuickSight, id s
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*quicksight.Dashboard); ok {
 		if status, apiErrors := aws.StringValue(out.Version.Status), out.Version.Errors; status == quicksight.ResourceStatusCreationFailed && apiErrors != nil {
 			var errs []error
@@ -171,7 +171,7 @@ func waitDashboardUpdated(ctx context.Context, conn *quicksight.QuickSight, id s
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*quicksight.Dashboard); ok {
 		if status, apiErrors := aws.StringValue(out.Version.Status), out.Version.Errors; status == quicksight.ResourceStatusCreationFailed && apiErrors != nil {
 			var errs []error
@@ -203,7 +203,7 @@ func waitAnalysisCreated(ctx context.Context, conn *quicksight.QuickSight, id st
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*quicksight.Analysis); ok {
 		if status, apiErrors := aws.StringValue(out.Status), out.Errors; status == quicksight.ResourceStatusCreationFailed && apiErrors != nil {
 			var errs []error
@@ -235,7 +235,7 @@ func waitAnalysisUpdated(ctx context.Context, conn *quicksight.QuickSight, id st
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*quicksight.Analysis); ok {
 		if status, apiErrors := aws.StringValue(out.Status), out.Errors; status == quicksight.ResourceStatusCreationFailed && apiErrors != nil {
 			var errs []error
@@ -267,7 +267,7 @@ func waitThemeCreated(ctx context.Context, conn *quicksight.QuickSight, id strin
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*quicksight.Theme); ok {
 		if status, apiErrors := aws.StringValue(out.Version.Status), out.Version.Errors; status == quicksight.ResourceStatusCreationFailed && apiErrors != nil {
 			var errs []error
@@ -299,7 +299,7 @@ func waitThemeUpdated(ctx context.Context, conn *quicksight.QuickSight, id strin
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*quicksight.Theme); ok {
 		if status, apiErrors := aws.StringValue(out.Version.Status), out.Version.Errors; status == quicksight.ResourceStatusCreationFailed && apiErrors != nil {
 			var errs []error
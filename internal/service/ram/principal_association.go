@@ -220,7 +220,7 @@ func waitPrincipalAssociationCreated(ctx context.Context, conn *ram.Client, reso
 		NotFoundChecks: 20,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ResourceShareAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
@@ -242,7 +242,7 @@ func waitPrincipalAssociationDeleted(ctx context.Context, conn *ram.Client, reso
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ResourceShareAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
@@ -240,7 +240,7 @@ func waitResourceAssociationCreated(ctx context.Context, conn *ram.Client, resou
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ResourceShareAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
@@ -262,7 +262,7 @@ func waitResourceAssociationDeleted(ctx context.Context, conn *ram.Client, resou
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ResourceShareAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
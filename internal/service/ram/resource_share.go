@@ -289,7 +289,7 @@ func waitResourceShareOwnedBySelfActive(ctx context.Context, conn *ram.Client, a
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ResourceShare); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
@@ -308,7 +308,7 @@ func waitResourceShareOwnedBySelfDeleted(ctx context.Context, conn *ram.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ResourceShare); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
@@ -384,7 +384,7 @@ func waitResourceShareInvitationAccepted(ctx context.Context, conn *ram.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if v, ok := outputRaw.(*awstypes.ResourceShareInvitation); ok {
 		return v, err
@@ -401,7 +401,7 @@ func waitResourceShareOwnedBySelfDisassociated(ctx context.Context, conn *ram.Cl
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ResourceShare); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
@@ -311,7 +311,7 @@ func waitRuleCreated(ctx context.Context, conn *rbin.Client, id string, timeout
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*rbin.GetRuleOutput); ok {
 		return out, err
 	}
@@ -329,7 +329,7 @@ func waitRuleUpdated(ctx context.Context, conn *rbin.Client, id string, timeout
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*rbin.GetRuleOutput); ok {
 		return out, err
 	}
@@ -345,7 +345,7 @@ func waitRuleDeleted(ctx context.Context, conn *rbin.Client, id string, timeout
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*rbin.GetRuleOutput); ok {
 		return out, err
 	}
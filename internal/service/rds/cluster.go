@@ -1895,7 +1895,7 @@ func waitDBClusterCreated(ctx context.Context, conn *rds.Client, id string, time
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBCluster); ok {
 		return output, err
@@ -1928,7 +1928,7 @@ func waitDBClusterUpdated(ctx context.Context, conn *rds.Client, id string, wait
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBCluster); ok {
 		return output, err
@@ -1954,7 +1954,7 @@ func waitDBClusterDeleted(ctx context.Context, conn *rds.Client, id string, time
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBCluster); ok {
 		return output, err
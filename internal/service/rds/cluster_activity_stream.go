@@ -188,7 +188,7 @@ func waitActivityStreamStarted(ctx context.Context, conn *rds.Client, arn string
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBCluster); ok {
 		return output, err
@@ -210,7 +210,7 @@ func waitActivityStreamStopped(ctx context.Context, conn *rds.Client, arn string
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBCluster); ok {
 		return output, err
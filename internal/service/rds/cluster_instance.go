@@ -579,7 +579,7 @@ func waitDBClusterInstanceCreated(ctx context.Context, conn *rds.RDS, id string,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.DBInstance); ok {
 		return output, err
@@ -612,7 +612,7 @@ func waitDBClusterInstanceUpdated(ctx context.Context, conn *rds.RDS, id string,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.DBInstance); ok {
 		return output, err
@@ -636,7 +636,7 @@ func waitDBClusterInstanceDeleted(ctx context.Context, conn *rds.RDS, id string,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*rds.DBInstance); ok {
 		return output, err
@@ -220,7 +220,7 @@ func waitDBClusterRoleAssociationCreated(ctx context.Context, conn *rds.Client,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBClusterRole); ok {
 		return output, err
@@ -239,7 +239,7 @@ func waitDBClusterRoleAssociationDeleted(ctx context.Context, conn *rds.Client,
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBClusterRole); ok {
 		return output, err
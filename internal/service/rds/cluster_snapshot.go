@@ -349,7 +349,7 @@ func waitDBClusterSnapshotCreated(ctx context.Context, conn *rds.Client, id stri
 		Delay:      5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBClusterSnapshot); ok {
 		return output, err
@@ -471,7 +471,7 @@ func waitGlobalClusterCreated(ctx context.Context, conn *rds.Client, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.GlobalCluster); ok {
 		return output, err
@@ -489,7 +489,7 @@ func waitGlobalClusterUpdated(ctx context.Context, conn *rds.Client, id string,
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.GlobalCluster); ok {
 		return output, err
@@ -507,7 +507,7 @@ func waitGlobalClusterDeleted(ctx context.Context, conn *rds.Client, id string,
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.GlobalCluster); ok {
 		return output, err
@@ -763,7 +763,7 @@ func waitGlobalClusterMemberUpdated(ctx context.Context, conn *rds.Client, id st
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBCluster); ok {
 		return output, err
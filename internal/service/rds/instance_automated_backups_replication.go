@@ -275,7 +275,7 @@ func waitDBInstanceAutomatedBackupCreated(ctx context.Context, conn *rds.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBInstanceAutomatedBackup); ok {
 		return output, err
@@ -213,7 +213,7 @@ func waitDBInstanceRoleAssociationCreated(ctx context.Context, conn *rds.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBInstanceRole); ok {
 		return output, err
@@ -230,7 +230,7 @@ func waitDBInstanceRoleAssociationDeleted(ctx context.Context, conn *rds.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBInstanceRole); ok {
 		return output, err
@@ -366,7 +366,7 @@ func waitDBProxyCreated(ctx context.Context, conn *rds.Client, name string, time
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBProxy); ok {
 		return output, err
@@ -383,7 +383,7 @@ func waitDBProxyDeleted(ctx context.Context, conn *rds.Client, name string, time
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBProxy); ok {
 		return output, err
@@ -400,7 +400,7 @@ func waitDBProxyUpdated(ctx context.Context, conn *rds.Client, name string, time
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.DBProxy); ok {
 		return output, err
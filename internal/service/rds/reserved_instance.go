@@ -291,7 +291,7 @@ func waitReservedInstanceCreated(ctx context.Context, conn *rds.Client, id strin
 		Delay:          30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.ReservedDBInstance); ok {
 		return output, err
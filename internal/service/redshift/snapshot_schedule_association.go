@@ -213,7 +213,7 @@ func waitSnapshotScheduleAssociationCreated(ctx context.Context, conn *redshift.
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshift.ClusterAssociatedToSchedule); ok {
 		return output, err
@@ -234,7 +234,7 @@ func waitSnapshotScheduleAssociationDeleted(ctx context.Context, conn *redshift.
 		MinTimeout: 10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*redshift.ClusterAssociatedToSchedule); ok {
 		return output, err
@@ -300,7 +300,7 @@ func waitEndpointAccessActive(ctx context.Context, conn *redshiftserverless.Clie
 		Timeout: 10 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.EndpointAccess); ok {
 		return output, err
@@ -319,7 +319,7 @@ func waitEndpointAccessDeleted(ctx context.Context, conn *redshiftserverless.Cli
 		Timeout: 10 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.EndpointAccess); ok {
 		return output, err
@@ -347,7 +347,7 @@ func waitNamespaceDeleted(ctx context.Context, conn *redshiftserverless.Client,
 		Timeout: namespaceDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Namespace); ok {
 		return output, err
@@ -364,7 +364,7 @@ func waitNamespaceUpdated(ctx context.Context, conn *redshiftserverless.Client,
 		Timeout: namespaceUpdatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Namespace); ok {
 		return output, err
@@ -77,7 +77,7 @@ func waitChangeInsync(ctx context.Context, conn *route53.Client, id string) (*aw
 		Timeout:      timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ChangeInfo); ok {
 		return output, err
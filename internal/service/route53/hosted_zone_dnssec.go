@@ -259,7 +259,7 @@ func waitHostedZoneDNSSECStatusUpdated(ctx context.Context, conn *route53.Client
 		Timeout:    timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DNSSECStatus); ok {
 		if serveSignature := aws.ToString(output.ServeSignature); serveSignature == serveSignatureInternalFailure {
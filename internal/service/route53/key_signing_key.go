@@ -376,7 +376,7 @@ func waitKeySigningKeyStatusUpdated(ctx context.Context, conn *route53.Client, h
 		Timeout:    timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.KeySigningKey); ok {
 		if status := aws.ToString(output.Status); status == keySigningKeyStatusInternalFailure {
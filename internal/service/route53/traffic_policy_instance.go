@@ -236,7 +236,7 @@ func waitTrafficPolicyInstanceStateCreated(ctx context.Context, conn *route53.Cl
 		Timeout: trafficPolicyInstanceOperationTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TrafficPolicyInstance); ok {
 		if state := aws.ToString(output.State); state == trafficPolicyInstanceStateFailed {
@@ -257,7 +257,7 @@ func waitTrafficPolicyInstanceStateUpdated(ctx context.Context, conn *route53.Cl
 		Timeout: trafficPolicyInstanceOperationTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TrafficPolicyInstance); ok {
 		if state := aws.ToString(output.State); state == trafficPolicyInstanceStateFailed {
@@ -278,7 +278,7 @@ func waitTrafficPolicyInstanceStateDeleted(ctx context.Context, conn *route53.Cl
 		Timeout: trafficPolicyInstanceOperationTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TrafficPolicyInstance); ok {
 		if state := aws.ToString(output.State); state == trafficPolicyInstanceStateFailed {
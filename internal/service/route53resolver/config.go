@@ -195,7 +195,7 @@ func waitAutodefinedReverseEnabled(ctx context.Context, conn *route53resolver.Cl
 		Timeout: autodefinedReverseUpdatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ResolverConfig); ok {
 		return output, err
@@ -212,7 +212,7 @@ func waitAutodefinedReverseDisabled(ctx context.Context, conn *route53resolver.C
 		Timeout: autodefinedReverseUpdatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ResolverConfig); ok {
 		return output, err
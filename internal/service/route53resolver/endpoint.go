@@ -377,7 +377,7 @@ func waitEndpointCreated(ctx context.Context, conn *route53resolver.Client, id s
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ResolverEndpoint); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
@@ -398,7 +398,7 @@ func waitEndpointUpdated(ctx context.Context, conn *route53resolver.Client, id s
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ResolverEndpoint); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
@@ -419,7 +419,7 @@ func waitEndpointDeleted(ctx context.Context, conn *route53resolver.Client, id s
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ResolverEndpoint); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
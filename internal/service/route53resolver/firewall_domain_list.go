@@ -261,7 +261,7 @@ func waitFirewallDomainListUpdated(ctx context.Context, conn *route53resolver.Cl
 		Timeout: firewallDomainListUpdatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.FirewallDomainList); ok {
 		if status := output.Status; status == awstypes.FirewallDomainListStatusCompleteImportFailed {
@@ -282,7 +282,7 @@ func waitFirewallDomainListDeleted(ctx context.Context, conn *route53resolver.Cl
 		Timeout: firewallDomainListDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.FirewallDomainList); ok {
 		return output, err
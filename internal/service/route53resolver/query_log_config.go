@@ -206,7 +206,7 @@ func waitQueryLogConfigCreated(ctx context.Context, conn *route53resolver.Client
 		Timeout: queryLogConfigCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ResolverQueryLogConfig); ok {
 		return output, err
@@ -223,7 +223,7 @@ func waitQueryLogConfigDeleted(ctx context.Context, conn *route53resolver.Client
 		Timeout: queryLogConfigDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ResolverQueryLogConfig); ok {
 		return output, err
@@ -525,7 +525,7 @@ func waitLifecycleRulesEquals(ctx context.Context, conn *s3.Client, bucket, expe
 		NotFoundChecks:            20,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]types.LifecycleRule); ok {
 		return output, err
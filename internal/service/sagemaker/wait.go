@@ -58,7 +58,7 @@ func waitEndpointDeleted(ctx context.Context, conn *sagemaker.Client, name strin
 		Timeout: endpointDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeEndpointOutput); ok {
 		if output.EndpointStatus == awstypes.EndpointStatusFailed {
@@ -79,7 +79,7 @@ func waitEndpointInService(ctx context.Context, conn *sagemaker.Client, name str
 		Timeout: endpointInServiceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeEndpointOutput); ok {
 		if output.EndpointStatus == awstypes.EndpointStatusFailed {
@@ -105,7 +105,7 @@ func waitNotebookInstanceInService(ctx context.Context, conn *sagemaker.Client,
 		Timeout: notebookInstanceInServiceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeNotebookInstanceOutput); ok {
 		if output.NotebookInstanceStatus == awstypes.NotebookInstanceStatusFailed {
@@ -126,7 +126,7 @@ func waitNotebookInstanceStarted(ctx context.Context, conn *sagemaker.Client, no
 		Timeout: 30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeNotebookInstanceOutput); ok {
 		if output.NotebookInstanceStatus == awstypes.NotebookInstanceStatusFailed {
@@ -147,7 +147,7 @@ func waitNotebookInstanceStopped(ctx context.Context, conn *sagemaker.Client, no
 		Timeout: notebookInstanceStoppedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeNotebookInstanceOutput); ok {
 		if output.NotebookInstanceStatus == awstypes.NotebookInstanceStatusFailed {
@@ -168,7 +168,7 @@ func waitNotebookInstanceDeleted(ctx context.Context, conn *sagemaker.Client, no
 		Timeout: notebookInstanceDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeNotebookInstanceOutput); ok {
 		if output.NotebookInstanceStatus == awstypes.NotebookInstanceStatusFailed {
@@ -189,7 +189,7 @@ func waitModelPackageGroupCompleted(ctx context.Context, conn *sagemaker.Client,
 		Timeout: modelPackageGroupCompletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeModelPackageGroupOutput); ok {
 		return output, err
@@ -206,7 +206,7 @@ func waitModelPackageGroupDeleted(ctx context.Context, conn *sagemaker.Client, n
 		Timeout: modelPackageGroupDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeModelPackageGroupOutput); ok {
 		return output, err
@@ -223,7 +223,7 @@ func waitImageCreated(ctx context.Context, conn *sagemaker.Client, name string)
 		Timeout: imageCreatedTimeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -236,7 +236,7 @@ func waitImageDeleted(ctx context.Context, conn *sagemaker.Client, name string)
 		Timeout: imageDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeImageOutput); ok {
 		return output, err
@@ -253,7 +253,7 @@ func waitImageVersionCreated(ctx context.Context, conn *sagemaker.Client, name s
 		Timeout: imageVersionCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeImageVersionOutput); ok {
 		return output, err
@@ -270,7 +270,7 @@ func waitImageVersionDeleted(ctx context.Context, conn *sagemaker.Client, name s
 		Timeout: imageVersionDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeImageVersionOutput); ok {
 		return output, err
@@ -287,7 +287,7 @@ func waitDomainInService(ctx context.Context, conn *sagemaker.Client, domainID s
 		Timeout: domainInServiceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeDomainOutput); ok {
 		if status, reason := output.Status, aws.ToString(output.FailureReason); status == awstypes.DomainStatusFailed || status == awstypes.DomainStatusUpdateFailed && reason != "" {
@@ -308,7 +308,7 @@ func waitDomainDeleted(ctx context.Context, conn *sagemaker.Client, domainID str
 		Timeout: domainDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeDomainOutput); ok {
 		if status, reason := output.Status, aws.ToString(output.FailureReason); status == awstypes.DomainStatusDeleteFailed && reason != "" {
@@ -329,7 +329,7 @@ func waitFeatureGroupCreated(ctx context.Context, conn *sagemaker.Client, name s
 		Timeout: featureGroupCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeFeatureGroupOutput); ok {
 		if status, reason := output.FeatureGroupStatus, aws.ToString(output.FailureReason); status == awstypes.FeatureGroupStatusCreateFailed && reason != "" {
@@ -350,7 +350,7 @@ func waitFeatureGroupDeleted(ctx context.Context, conn *sagemaker.Client, name s
 		Timeout: featureGroupDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeFeatureGroupOutput); ok {
 		if status, reason := output.FeatureGroupStatus, aws.ToString(output.FailureReason); status == awstypes.FeatureGroupStatusDeleteFailed && reason != "" {
@@ -371,7 +371,7 @@ func waitUserProfileInService(ctx context.Context, conn *sagemaker.Client, domai
 		Timeout: userProfileInServiceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeUserProfileOutput); ok {
 		if status, reason := output.Status, aws.ToString(output.FailureReason); status == awstypes.UserProfileStatusFailed || status == awstypes.UserProfileStatusUpdateFailed && reason != "" {
@@ -392,7 +392,7 @@ func waitUserProfileDeleted(ctx context.Context, conn *sagemaker.Client, domainI
 		Timeout: userProfileDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeUserProfileOutput); ok {
 		if status, reason := output.Status, aws.ToString(output.FailureReason); status == awstypes.UserProfileStatusDeleteFailed && reason != "" {
@@ -413,7 +413,7 @@ func waitAppInService(ctx context.Context, conn *sagemaker.Client, domainID, use
 		Timeout: appInServiceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeAppOutput); ok {
 		if status, reason := output.Status, aws.ToString(output.FailureReason); status == awstypes.AppStatusFailed && reason != "" {
@@ -434,7 +434,7 @@ func waitAppDeleted(ctx context.Context, conn *sagemaker.Client, domainID, userP
 		Timeout: appDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeAppOutput); ok {
 		if status, reason := output.Status, aws.ToString(output.FailureReason); status == awstypes.AppStatusFailed && reason != "" {
@@ -455,7 +455,7 @@ func waitFlowDefinitionActive(ctx context.Context, conn *sagemaker.Client, name
 		Timeout: flowDefinitionActiveTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeFlowDefinitionOutput); ok {
 		if status, reason := output.FlowDefinitionStatus, aws.ToString(output.FailureReason); status == awstypes.FlowDefinitionStatusFailed && reason != "" {
@@ -476,7 +476,7 @@ func waitFlowDefinitionDeleted(ctx context.Context, conn *sagemaker.Client, name
 		Timeout: flowDefinitionDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeFlowDefinitionOutput); ok {
 		if status, reason := output.FlowDefinitionStatus, aws.ToString(output.FailureReason); status == awstypes.FlowDefinitionStatusFailed && reason != "" {
@@ -497,7 +497,7 @@ func waitProjectDeleted(ctx context.Context, conn *sagemaker.Client, name string
 		Timeout: projectDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeProjectOutput); ok {
 		if status, reason := output.ProjectStatus, aws.ToString(output.ServiceCatalogProvisionedProductDetails.ProvisionedProductStatusMessage); status == awstypes.ProjectStatusDeleteFailed && reason != "" {
@@ -518,7 +518,7 @@ func waitProjectCreated(ctx context.Context, conn *sagemaker.Client, name string
 		Timeout: projectCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeProjectOutput); ok {
 		if status, reason := output.ProjectStatus, aws.ToString(output.ServiceCatalogProvisionedProductDetails.ProvisionedProductStatusMessage); status == awstypes.ProjectStatusCreateFailed && reason != "" {
@@ -539,7 +539,7 @@ func waitProjectUpdated(ctx context.Context, conn *sagemaker.Client, name string
 		Timeout: projectCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeProjectOutput); ok {
 		if status, reason := output.ProjectStatus, aws.ToString(output.ServiceCatalogProvisionedProductDetails.ProvisionedProductStatusMessage); status == awstypes.ProjectStatusUpdateFailed && reason != "" {
@@ -560,7 +560,7 @@ func waitWorkforceActive(ctx context.Context, conn *sagemaker.Client, name strin
 		Timeout: workforceActiveTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Workforce); ok {
 		if status, reason := output.Status, aws.ToString(output.FailureReason); status == awstypes.WorkforceStatusFailed && reason != "" {
@@ -581,7 +581,7 @@ func waitWorkforceDeleted(ctx context.Context, conn *sagemaker.Client, name stri
 		Timeout: workforceDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.Workforce); ok {
 		if status, reason := output.Status, aws.ToString(output.FailureReason); status == awstypes.WorkforceStatusFailed && reason != "" {
@@ -602,7 +602,7 @@ func waitSpaceInService(ctx context.Context, conn *sagemaker.Client, domainId, n
 		Timeout: spaceInServiceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeSpaceOutput); ok {
 		if status, reason := output.Status, aws.ToString(output.FailureReason); status == awstypes.SpaceStatusUpdateFailed && reason != "" {
@@ -623,7 +623,7 @@ func waitSpaceDeleted(ctx context.Context, conn *sagemaker.Client, domainId, nam
 		Timeout: spaceDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeSpaceOutput); ok {
 		if status, reason := output.Status, aws.ToString(output.FailureReason); status == awstypes.SpaceStatusDeleteFailed && reason != "" {
@@ -644,7 +644,7 @@ func waitMonitoringScheduleScheduled(ctx context.Context, conn *sagemaker.Client
 		Timeout: monitoringScheduleScheduledTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeMonitoringScheduleOutput); ok {
 		if status, reason := output.MonitoringScheduleStatus, aws.ToString(output.FailureReason); status == awstypes.ScheduleStatusFailed && reason != "" {
@@ -665,7 +665,7 @@ func waitMonitoringScheduleNotFound(ctx context.Context, conn *sagemaker.Client,
 		Timeout: monitoringScheduleStoppedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeMonitoringScheduleOutput); ok {
 		if status, reason := output.MonitoringScheduleStatus, aws.ToString(output.FailureReason); status == awstypes.ScheduleStatusFailed && reason != "" {
@@ -229,7 +229,7 @@ func waitOrganizationConfigurationEnabled(ctx context.Context, conn *securityhub
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*securityhub.DescribeOrganizationConfigurationOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.OrganizationConfiguration.StatusMessage)))
@@ -448,7 +448,7 @@ func waitDataLakeCreated(ctx context.Context, conn *securitylake.Client, arn str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DataLakeResource); ok {
 		if v := output.UpdateStatus; v != nil {
@@ -471,7 +471,7 @@ func waitDataLakeUpdated(ctx context.Context, conn *securitylake.Client, arn str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DataLakeResource); ok {
 		if v := output.UpdateStatus; v != nil {
@@ -494,7 +494,7 @@ func waitDataLakeDeleted(ctx context.Context, conn *securitylake.Client, arn str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DataLakeResource); ok {
 		if v := output.UpdateStatus; v != nil {
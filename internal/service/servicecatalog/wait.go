@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -86,7 +87,7 @@ func waitProductReady(ctx context.Context, conn *servicecatalog.Client, acceptLa
 		MinTimeout:                minTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.DescribeProductAsAdminOutput); ok {
 		return output, err
@@ -103,7 +104,7 @@ func waitProductDeleted(ctx context.Context, conn *servicecatalog.Client, accept
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.DescribeProductAsAdminOutput); ok {
 		return output, err
@@ -124,7 +125,7 @@ func waitTagOptionReady(ctx context.Context, conn *servicecatalog.Client, id str
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.TagOptionDetail); ok {
 		return output, err
@@ -141,7 +142,7 @@ func waitTagOptionDeleted(ctx context.Context, conn *servicecatalog.Client, id s
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
 		return nil
@@ -164,7 +165,7 @@ func waitPortfolioShareReady(ctx context.Context, conn *servicecatalog.Client, p
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.PortfolioShareDetail); ok {
 		return output, err
@@ -187,7 +188,7 @@ func waitPortfolioShareCreatedWithToken(ctx context.Context, conn *servicecatalo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.DescribePortfolioShareStatusOutput); ok {
 		return output, err
@@ -204,7 +205,7 @@ func waitPortfolioShareDeleted(ctx context.Context, conn *servicecatalog.Client,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.PortfolioShareDetail); ok {
 		return output, err
@@ -221,7 +222,7 @@ func waitPortfolioShareDeletedWithToken(ctx context.Context, conn *servicecatalo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.DescribePortfolioShareStatusOutput); ok {
 		return output, err
@@ -238,7 +239,7 @@ func waitOrganizationsAccessStable(ctx context.Context, conn *servicecatalog.Cli
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.GetAWSOrganizationsAccessStatusOutput); ok {
 		return string(output.AccessStatus), err
@@ -258,7 +259,7 @@ func waitConstraintReady(ctx context.Context, conn *servicecatalog.Client, accep
 		MinTimeout:                minTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.DescribeConstraintOutput); ok {
 		return output, err
@@ -275,7 +276,7 @@ func waitConstraintDeleted(ctx context.Context, conn *servicecatalog.Client, acc
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -291,7 +292,7 @@ func waitProductPortfolioAssociationReady(ctx context.Context, conn *servicecata
 		MinTimeout:                minTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.PortfolioDetail); ok {
 		return output, err
@@ -308,7 +309,7 @@ func waitProductPortfolioAssociationDeleted(ctx context.Context, conn *serviceca
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -321,7 +322,7 @@ func waitServiceActionReady(ctx context.Context, conn *servicecatalog.Client, ac
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ServiceActionDetail); ok {
 		return output, err
@@ -338,7 +339,7 @@ func waitServiceActionDeleted(ctx context.Context, conn *servicecatalog.Client,
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
 		return nil
@@ -355,7 +356,7 @@ func waitBudgetResourceAssociationReady(ctx context.Context, conn *servicecatalo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.BudgetDetail); ok {
 		return output, err
@@ -372,7 +373,7 @@ func waitBudgetResourceAssociationDeleted(ctx context.Context, conn *servicecata
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -385,7 +386,7 @@ func waitTagOptionResourceAssociationReady(ctx context.Context, conn *servicecat
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ResourceDetail); ok {
 		return output, err
@@ -402,7 +403,7 @@ func waitTagOptionResourceAssociationDeleted(ctx context.Context, conn *servicec
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -418,7 +419,7 @@ func waitProvisioningArtifactReady(ctx context.Context, conn *servicecatalog.Cli
 		MinTimeout:                minTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.DescribeProvisioningArtifactOutput); ok {
 		return output, err
@@ -435,7 +436,7 @@ func waitProvisioningArtifactDeleted(ctx context.Context, conn *servicecatalog.C
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
 		return nil
@@ -455,7 +456,7 @@ func waitLaunchPathsReady(ctx context.Context, conn *servicecatalog.Client, acce
 		MinTimeout:                minTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]awstypes.LaunchPathSummary); ok {
 		return output, err
@@ -475,7 +476,7 @@ func waitProvisionedProductReady(ctx context.Context, conn *servicecatalog.Clien
 		MinTimeout:                minTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.DescribeProvisionedProductOutput); ok {
 		if detail := output.ProvisionedProductDetail; detail != nil {
@@ -503,7 +504,7 @@ func waitProvisionedProductTerminated(ctx context.Context, conn *servicecatalog.
 		Timeout: timeout,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	return err
 }
@@ -516,7 +517,7 @@ func waitPortfolioConstraintsReady(ctx context.Context, conn *servicecatalog.Cli
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.([]awstypes.ConstraintDetail); ok {
 		return output, err
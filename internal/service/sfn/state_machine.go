@@ -456,7 +456,7 @@ func waitStateMachineDeleted(ctx context.Context, conn *sfn.Client, stateMachine
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*sfn.DescribeStateMachineOutput); ok {
 		return output, err
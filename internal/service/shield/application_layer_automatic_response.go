@@ -298,7 +298,7 @@ func waitApplicationLayerAutomaticResponseEnabled(ctx context.Context, conn *shi
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ApplicationLayerAutomaticResponseConfiguration); ok {
 		return output, err
@@ -315,7 +315,7 @@ func waitApplicationLayerAutomaticResponseDeleted(ctx context.Context, conn *shi
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.ApplicationLayerAutomaticResponseConfiguration); ok {
 		return output, err
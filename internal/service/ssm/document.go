@@ -607,7 +607,7 @@ func waitDocumentActive(ctx context.Context, conn *ssm.Client, name string) (*aw
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DocumentDescription); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusInformation)))
@@ -629,7 +629,7 @@ func waitDocumentDeleted(ctx context.Context, conn *ssm.Client, name string) (*a
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DocumentDescription); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusInformation)))
@@ -380,7 +380,7 @@ func waitAccountAssignmentCreated(ctx context.Context, conn *ssoadmin.Client, in
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.AccountAssignmentOperationStatus); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureReason)))
@@ -401,7 +401,7 @@ func waitAccountAssignmentDeleted(ctx context.Context, conn *ssoadmin.Client, in
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.AccountAssignmentOperationStatus); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureReason)))
@@ -875,7 +875,7 @@ func waitGatewayConnected(ctx context.Context, conn *storagegateway.Client, gate
 		ContinuousTargetOccurence: 6, // Gateway activations can take a few seconds and can trigger a reboot of the Gateway.
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*storagegateway.DescribeGatewayInformationOutput); ok {
 		return output, err
@@ -895,7 +895,7 @@ func waitGatewayJoinDomainJoined(ctx context.Context, conn *storagegateway.Clien
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*storagegateway.DescribeSMBSettingsOutput); ok {
 		return output, err
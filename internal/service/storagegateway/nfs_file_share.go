@@ -460,7 +460,7 @@ func waitNFSFileShareCreated(ctx context.Context, conn *storagegateway.Client, a
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.NFSFileShareInfo); ok {
 		return output, err
@@ -478,7 +478,7 @@ func waitNFSFileShareUpdated(ctx context.Context, conn *storagegateway.Client, a
 		Delay:   5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.NFSFileShareInfo); ok {
 		return output, err
@@ -497,7 +497,7 @@ func waitNFSFileShareDeleted(ctx context.Context, conn *storagegateway.Client, a
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.NFSFileShareInfo); ok {
 		return output, err
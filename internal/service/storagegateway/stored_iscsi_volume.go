@@ -328,7 +328,7 @@ func waitStorediSCSIVolumeAvailable(ctx context.Context, conn *storagegateway.Cl
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.StorediSCSIVolume); ok {
 		return output, err
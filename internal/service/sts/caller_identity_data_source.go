@@ -7,9 +7,11 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -66,7 +68,9 @@ func (d *dataSourceCallerIdentity) Read(ctx context.Context, request datasource.
 
 	conn := d.Meta().STSClient(ctx)
 
-	output, err := FindCallerIdentity(ctx, conn)
+	output, err := conns.CachedLookup(ctx, d.Meta(), names.STS, "GetCallerIdentity", nil, func(ctx context.Context) (*sts.GetCallerIdentityOutput, error) {
+		return FindCallerIdentity(ctx, conn)
+	})
 
 	if err != nil {
 		response.Diagnostics.AddError("reading STS Caller Identity", err.Error())
@@ -206,7 +206,7 @@ func waitMedicalVocabularyCreated(ctx context.Context, conn *transcribe.Client,
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*transcribe.GetMedicalVocabularyOutput); ok {
 		return out, err
 	}
@@ -225,7 +225,7 @@ func waitMedicalVocabularyUpdated(ctx context.Context, conn *transcribe.Client,
 		Delay:                     30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*transcribe.GetMedicalVocabularyOutput); ok {
 		return out, err
 	}
@@ -242,7 +242,7 @@ func waitMedicalVocabularyDeleted(ctx context.Context, conn *transcribe.Client,
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*transcribe.GetMedicalVocabularyOutput); ok {
 		return out, err
 	}
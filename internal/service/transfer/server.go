@@ -945,7 +945,7 @@ func waitServerCreated(ctx context.Context, conn *transfer.Client, id string, ti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DescribedServer); ok {
 		return output, err
@@ -965,7 +965,7 @@ func waitServerDeleted(ctx context.Context, conn *transfer.Client, id string) (*
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DescribedServer); ok {
 		return output, err
@@ -982,7 +982,7 @@ func waitServerStarted(ctx context.Context, conn *transfer.Client, id string, ti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DescribedServer); ok {
 		return output, err
@@ -999,7 +999,7 @@ func waitServerStopped(ctx context.Context, conn *transfer.Client, id string, ti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*awstypes.DescribedServer); ok {
 		return output, err
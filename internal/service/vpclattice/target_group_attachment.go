@@ -220,7 +220,7 @@ func waitTargetGroupAttachmentCreated(ctx context.Context, conn *vpclattice.Clie
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.TargetSummary); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.ReasonCode)))
@@ -239,7 +239,7 @@ func waitTargetGroupAttachmentDeleted(ctx context.Context, conn *vpclattice.Clie
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 
 	if output, ok := outputRaw.(*types.TargetSummary); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.ReasonCode)))
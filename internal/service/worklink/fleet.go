@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -309,7 +310,7 @@ func resourceFleetDelete(ctx context.Context, d *schema.ResourceData, meta inter
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for WorkLink Fleet (%s) to become deleted: %s", d.Id(), err)
 	}
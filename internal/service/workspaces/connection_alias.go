@@ -238,7 +238,7 @@ func waitConnectionAliasCreated(ctx context.Context, conn *workspaces.Client, id
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.ConnectionAlias); ok {
 		return out, err
 	}
@@ -254,7 +254,7 @@ func waitConnectionAliasDeleted(ctx context.Context, conn *workspaces.Client, id
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf)
 	if out, ok := outputRaw.(*awstypes.ConnectionAlias); ok {
 		return out, err
 	}
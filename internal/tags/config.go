@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+)
+
+// RequiredConfig contains tags that must be present on all resources.
+type RequiredConfig struct {
+	Tags []RequiredTag
+}
+
+// RequiredTag is a tag that must be present on all resources.
+// If ValueRegex is set, the tag's value must match it.
+type RequiredTag struct {
+	Key        string
+	ValueRegex *regexp.Regexp
+}
+
+// ForResourceType returns the DefaultConfig that applies to the specified resource type.
+// Returns nil if the resource type is excluded, or if resource types are included and the resource type is not one of them.
+func (dc *DefaultConfig) ForResourceType(typeName string) *DefaultConfig {
+	if dc == nil {
+		return nil
+	}
+
+	if len(dc.IncludeResourceTypes) > 0 && !matchAny(dc.IncludeResourceTypes, typeName) {
+		return nil
+	}
+
+	if matchAny(dc.ExcludeResourceTypes, typeName) {
+		return nil
+	}
+
+	return dc
+}
+
+// matchAny returns whether the name matches any of the specified path.Match patterns.
+func matchAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate returns an error if any required tag is missing or has a value that does not match the required pattern.
+func (rc *RequiredConfig) Validate(tags KeyValueTags) error {
+	if rc == nil {
+		return nil
+	}
+
+	var errs []error
+
+	for _, rt := range rc.Tags {
+		v, ok := tags[rt.Key]
+		if !ok {
+			errs = append(errs, fmt.Errorf("required tag %q is missing", rt.Key))
+			continue
+		}
+
+		if rt.ValueRegex != nil {
+			if value := v.ValueString(); !rt.ValueRegex.MatchString(value) {
+				errs = append(errs, fmt.Errorf("required tag %q value %q does not match %q", rt.Key, value, rt.ValueRegex.String()))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestDefaultConfigForResourceType(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tags := New(ctx, map[string]string{
+		"key1": "value1",
+	})
+
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		typeName      string
+		want          bool
+	}{
+		{
+			name:     "no config",
+			typeName: "aws_vpc",
+		},
+		{
+			name: "no resource types",
+			defaultConfig: &DefaultConfig{
+				Tags: tags,
+			},
+			typeName: "aws_vpc",
+			want:     true,
+		},
+		{
+			name: "included",
+			defaultConfig: &DefaultConfig{
+				Tags:                 tags,
+				IncludeResourceTypes: []string{"aws_s3_*", "aws_vpc"},
+			},
+			typeName: "aws_s3_bucket",
+			want:     true,
+		},
+		{
+			name: "not included",
+			defaultConfig: &DefaultConfig{
+				Tags:                 tags,
+				IncludeResourceTypes: []string{"aws_s3_*", "aws_vpc"},
+			},
+			typeName: "aws_subnet",
+		},
+		{
+			name: "excluded",
+			defaultConfig: &DefaultConfig{
+				Tags:                 tags,
+				ExcludeResourceTypes: []string{"aws_s3_*"},
+			},
+			typeName: "aws_s3_object",
+		},
+		{
+			name: "not excluded",
+			defaultConfig: &DefaultConfig{
+				Tags:                 tags,
+				ExcludeResourceTypes: []string{"aws_s3_*"},
+			},
+			typeName: "aws_vpc",
+			want:     true,
+		},
+		{
+			name: "included and excluded",
+			defaultConfig: &DefaultConfig{
+				Tags:                 tags,
+				ExcludeResourceTypes: []string{"aws_s3_object"},
+				IncludeResourceTypes: []string{"aws_s3_*"},
+			},
+			typeName: "aws_s3_object",
+		},
+		{
+			name: "pattern does not match path separator",
+			defaultConfig: &DefaultConfig{
+				Tags:                 tags,
+				IncludeResourceTypes: []string{"aws_*"},
+			},
+			typeName: "aws_/vpc",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.ForResourceType(testCase.typeName)
+
+			if testCase.want {
+				if got != testCase.defaultConfig {
+					t.Errorf("expected default tags to apply to %s", testCase.typeName)
+				}
+			} else if got != nil {
+				t.Errorf("expected default tags not to apply to %s", testCase.typeName)
+			}
+		})
+	}
+}
+
+func TestRequiredConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	requiredConfig := &RequiredConfig{
+		Tags: []RequiredTag{
+			{
+				Key: "Owner",
+			},
+			{
+				Key:        "CostCenter",
+				ValueRegex: regexp.MustCompile(`^cc-[0-9]{4}$`),
+			},
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		requiredConfig *RequiredConfig
+		tags           KeyValueTags
+		wantErrs       []string
+	}{
+		{
+			name: "no config",
+			tags: New(ctx, map[string]string{}),
+		},
+		{
+			name:           "valid",
+			requiredConfig: requiredConfig,
+			tags: New(ctx, map[string]string{
+				"CostCenter": "cc-1234",
+				"Owner":      "team",
+			}),
+		},
+		{
+			name:           "missing",
+			requiredConfig: requiredConfig,
+			tags: New(ctx, map[string]string{
+				"CostCenter": "cc-1234",
+			}),
+			wantErrs: []string{`required tag "Owner" is missing`},
+		},
+		{
+			name:           "invalid value",
+			requiredConfig: requiredConfig,
+			tags: New(ctx, map[string]string{
+				"CostCenter": "1234",
+				"Owner":      "",
+			}),
+			wantErrs: []string{`required tag "CostCenter" value "1234" does not match`},
+		},
+		{
+			name:           "missing and invalid value",
+			requiredConfig: requiredConfig,
+			tags: New(ctx, map[string]string{
+				"CostCenter": "cc-12345",
+			}),
+			wantErrs: []string{
+				`required tag "Owner" is missing`,
+				`required tag "CostCenter" value "cc-12345" does not match`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.requiredConfig.Validate(testCase.tags)
+
+			if len(testCase.wantErrs) == 0 {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			for _, want := range testCase.wantErrs {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("expected error to contain %q, got %q", want, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// DriftSummary is the summary of the warning diagnostic reporting tag drift.
+const DriftSummary = "Tags changed outside of Terraform"
+
+// TagDrift describes a tag whose value read from AWS differs from the value Terraform expected.
+type TagDrift struct {
+	Key string
+	// OldValue is nil if the tag was added outside of Terraform.
+	OldValue *string
+	// NewValue is nil if the tag was removed outside of Terraform or is no longer tracked because of ignore_tags.
+	NewValue *string
+
+	source tagSource
+}
+
+func (td TagDrift) String() string {
+	var change string
+	switch {
+	case td.OldValue == nil:
+		change = fmt.Sprintf("added with value %q", aws.ToString(td.NewValue))
+	case td.NewValue == nil && td.source == ignoreTags:
+		change = fmt.Sprintf("no longer tracked, was %q", aws.ToString(td.OldValue))
+	case td.NewValue == nil:
+		change = fmt.Sprintf("removed, was %q", aws.ToString(td.OldValue))
+	default:
+		change = fmt.Sprintf("changed from %q to %q", aws.ToString(td.OldValue), aws.ToString(td.NewValue))
+	}
+
+	return fmt.Sprintf("%q: %s (%s)", td.Key, change, td.source)
+}
+
+// TagsDrift is the set of tags that changed outside of Terraform, ordered by key.
+type TagsDrift []TagDrift
+
+// Detail returns the detail of the warning diagnostic reporting tag drift.
+func (d TagsDrift) Detail(description string) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "The following tags on %s differ from those Terraform expected:\n", description)
+	for _, v := range d {
+		fmt.Fprintf(&b, "\n  - %s", v)
+	}
+
+	return b.String()
+}
+
+// Drift returns the tags read from AWS that differ from those Terraform expected, e.g. tags_all in prior state.
+// The receiver must not have had ignore_tags applied.
+// Each drifted tag is attributed to the resource's tags (in configuration, plan or state), the provider's default_tags
+// or ignore_tags, or is not managed by Terraform.
+func (tags KeyValueTags) Drift(expected KeyValueTags, defaultConfig *DefaultConfig, ignoreConfig *IgnoreConfig, d schemaResourceData) TagsDrift {
+	resourceTags := make(map[string]configTag)
+
+	if d != nil {
+		for _, v := range []struct {
+			raw    func() cty.Value
+			source tagSource
+		}{
+			{d.GetRawConfig, configuration},
+			{d.GetRawPlan, plan},
+			{d.GetRawState, state},
+		} {
+			if raw := v.raw(); !raw.IsNull() && raw.IsKnown() {
+				if c := raw.GetAttr(names.AttrTags); !c.IsNull() && c.IsKnown() {
+					normalizeTagsFromRaw(c.AsValueMap(), resourceTags, v.source)
+				}
+			}
+		}
+	}
+
+	return tags.drift(expected, defaultConfig, ignoreConfig, resourceTags)
+}
+
+// DriftFramework returns the tags read from AWS that differ from those Terraform expected, e.g. tags_all in prior state.
+// The receiver must not have had ignore_tags applied.
+// Each drifted tag is attributed to the resource's tags in state, the provider's default_tags
+// or ignore_tags, or is not managed by Terraform.
+func (tags KeyValueTags) DriftFramework(expected KeyValueTags, defaultConfig *DefaultConfig, ignoreConfig *IgnoreConfig, stateTags KeyValueTags) TagsDrift {
+	resourceTags := make(map[string]configTag, len(stateTags))
+
+	for k, v := range stateTags.Map() {
+		resourceTags[k] = configTag{
+			value:  v,
+			source: state,
+		}
+	}
+
+	return tags.drift(expected, defaultConfig, ignoreConfig, resourceTags)
+}
+
+func (tags KeyValueTags) drift(expected KeyValueTags, defaultConfig *DefaultConfig, ignoreConfig *IgnoreConfig, resourceTags map[string]configTag) TagsDrift {
+	var result TagsDrift
+
+	tracked := tags.IgnoreConfig(ignoreConfig)
+
+	sourceOf := func(k string) tagSource {
+		if v, ok := resourceTags[k]; ok {
+			return v.source
+		}
+		if defaultConfig != nil && defaultConfig.Tags.KeyExists(k) {
+			return defaultTags
+		}
+		return unmanaged
+	}
+
+	for k, v := range tracked {
+		if old, ok := expected[k]; !ok {
+			result = append(result, TagDrift{
+				Key:      k,
+				NewValue: aws.String(v.ValueString()),
+				source:   sourceOf(k),
+			})
+		} else if old.ValueString() != v.ValueString() {
+			result = append(result, TagDrift{
+				Key:      k,
+				OldValue: aws.String(old.ValueString()),
+				NewValue: aws.String(v.ValueString()),
+				source:   sourceOf(k),
+			})
+		}
+	}
+
+	for k, old := range expected {
+		if tracked.KeyExists(k) {
+			continue
+		}
+
+		source := sourceOf(k)
+		if tags.KeyExists(k) {
+			source = ignoreTags
+		}
+
+		result = append(result, TagDrift{
+			Key:      k,
+			OldValue: aws.String(old.ValueString()),
+			source:   source,
+		})
+	}
+
+	slices.SortFunc(result, func(a, b TagDrift) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	return result
+}
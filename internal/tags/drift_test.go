@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
+)
+
+type driftResourceData struct {
+	config cty.Value
+}
+
+func (d driftResourceData) GetRawConfig() cty.Value {
+	return d.config
+}
+
+func (d driftResourceData) GetRawPlan() cty.Value {
+	return cty.NullVal(cty.DynamicPseudoType)
+}
+
+func (d driftResourceData) GetRawState() cty.Value {
+	return cty.NullVal(cty.DynamicPseudoType)
+}
+
+func TestKeyValueTagsDrift(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultConfig := &DefaultConfig{
+		Tags: New(ctx, map[string]string{
+			"Owner": "team",
+		}),
+	}
+	ignoreConfig := &IgnoreConfig{
+		Keys: New(ctx, []string{"Ignored"}),
+	}
+	d := driftResourceData{
+		config: cty.ObjectVal(map[string]cty.Value{
+			"tags": cty.MapVal(map[string]cty.Value{
+				"Env": cty.StringVal("prod"),
+			}),
+		}),
+	}
+
+	testCases := []struct {
+		name     string
+		tags     KeyValueTags
+		expected KeyValueTags
+		want     []string
+	}{
+		{
+			name: "no drift",
+			tags: New(ctx, map[string]string{
+				"Env":     "prod",
+				"Ignored": "anything",
+				"Owner":   "team",
+			}),
+			expected: New(ctx, map[string]string{
+				"Env":   "prod",
+				"Owner": "team",
+			}),
+		},
+		{
+			name: "added",
+			tags: New(ctx, map[string]string{
+				"Env":      "prod",
+				"External": "x",
+				"Owner":    "team",
+			}),
+			expected: New(ctx, map[string]string{
+				"Env":   "prod",
+				"Owner": "team",
+			}),
+			want: []string{
+				`"External": added with value "x" (not managed by Terraform)`,
+			},
+		},
+		{
+			name: "changed",
+			tags: New(ctx, map[string]string{
+				"Env":   "dev",
+				"Owner": "someone",
+			}),
+			expected: New(ctx, map[string]string{
+				"Env":   "prod",
+				"Owner": "team",
+			}),
+			want: []string{
+				`"Env": changed from "prod" to "dev" (resource tags)`,
+				`"Owner": changed from "team" to "someone" (provider default_tags)`,
+			},
+		},
+		{
+			name: "removed",
+			tags: New(ctx, map[string]string{}),
+			expected: New(ctx, map[string]string{
+				"Env":   "prod",
+				"Owner": "team",
+			}),
+			want: []string{
+				`"Env": removed, was "prod" (resource tags)`,
+				`"Owner": removed, was "team" (provider default_tags)`,
+			},
+		},
+		{
+			name: "ignored",
+			tags: New(ctx, map[string]string{
+				"Env":     "prod",
+				"Ignored": "y",
+				"Owner":   "team",
+			}),
+			expected: New(ctx, map[string]string{
+				"Env":     "prod",
+				"Ignored": "x",
+				"Owner":   "team",
+			}),
+			want: []string{
+				`"Ignored": no longer tracked, was "x" (provider ignore_tags)`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, v := range testCase.tags.Drift(testCase.expected, defaultConfig, ignoreConfig, d) {
+				got = append(got, v.String())
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestKeyValueTagsDriftFramework(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tags := New(ctx, map[string]string{
+		"Env": "dev",
+	})
+	expected := New(ctx, map[string]string{
+		"Env": "prod",
+	})
+	stateTags := New(ctx, map[string]string{
+		"Env": "prod",
+	})
+
+	drift := tags.DriftFramework(expected, nil, nil, stateTags)
+
+	if got, want := len(drift), 1; got != want {
+		t.Fatalf("length of drift = %d, want %d", got, want)
+	}
+
+	detail := drift.Detail("Test aws_test (id)")
+	for _, want := range []string{
+		"The following tags on Test aws_test (id) differ from those Terraform expected:",
+		`- "Env": changed from "prod" to "dev" (resource tags)`,
+	} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("expected detail to contain %q, got %q", want, detail)
+		}
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"net/url"
 	"reflect"
+	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -53,12 +55,20 @@ const (
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+	// ExcludeResourceTypes and IncludeResourceTypes are resource type name patterns, e.g. "aws_s3_*", that scope the default tags.
+	// Patterns use path.Match syntax.
+	ExcludeResourceTypes []string
+	IncludeResourceTypes []string
 }
 
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
 	Keys        KeyValueTags
 	KeyPrefixes KeyValueTags
+	// KeyGlobs are tag key patterns, e.g. "team/*/owner", that use path.Match syntax.
+	KeyGlobs []string
+	// KeyPatterns are tag key regular expressions.
+	KeyPatterns []*regexp.Regexp
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -127,6 +137,8 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 
 	result := tags.IgnorePrefixes(config.KeyPrefixes)
 	result = result.Ignore(config.Keys)
+	result = result.IgnoreGlobs(config.KeyGlobs)
+	result = result.IgnorePatterns(config.KeyPatterns)
 
 	return result
 }
@@ -178,6 +190,44 @@ func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValue
 	return result
 }
 
+// IgnoreGlobs returns tag keys not matching any of the specified path.Match patterns.
+func (tags KeyValueTags) IgnoreGlobs(globs []string) KeyValueTags {
+	if len(globs) == 0 {
+		return tags
+	}
+
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if matchAny(globs, k) {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnorePatterns returns tag keys not matching any of the specified regular expressions.
+func (tags KeyValueTags) IgnorePatterns(patterns []*regexp.Regexp) KeyValueTags {
+	if len(patterns) == 0 {
+		return tags
+	}
+
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if slices.ContainsFunc(patterns, func(re *regexp.Regexp) bool { return re.MatchString(k) }) {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // IgnoreServerlessApplicationRepository returns non-AWS and non-ServerlessApplicationRepository tag keys.
 func (tags KeyValueTags) IgnoreServerlessApplicationRepository() KeyValueTags {
 	result := make(KeyValueTags)
@@ -737,8 +787,24 @@ const (
 	configuration tagSource = iota
 	plan
 	state
+	defaultTags
+	ignoreTags
+	unmanaged
 )
 
+func (s tagSource) String() string {
+	switch s {
+	case configuration, plan, state:
+		return "resource tags"
+	case defaultTags:
+		return "provider default_tags"
+	case ignoreTags:
+		return "provider ignore_tags"
+	default:
+		return "not managed by Terraform"
+	}
+}
+
 // configTag contains the value and source of the incoming tag
 type configTag struct {
 	value  string
@@ -5,6 +5,7 @@ package tags
 
 import (
 	"context"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -568,6 +569,66 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "key globs",
+			tags: New(ctx, map[string]string{
+				"aws:cloudformation:stack-name": "value1",
+				"billing-managed-by":            "value2",
+				"team/platform/owner":           "value3",
+				"team/platform/sub/owner":       "value4",
+				"key5":                          "value5",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyGlobs: []string{
+					"aws:cloudformation:*",
+					"*-managed-by",
+					"team/*/owner",
+				},
+			},
+			want: map[string]string{
+				"team/platform/sub/owner": "value4",
+				"key5":                    "value5",
+			},
+		},
+		{
+			name: "key patterns",
+			tags: New(ctx, map[string]string{
+				"aws:cloudformation:stack-name": "value1",
+				"billing-managed-by":            "value2",
+				"team/platform/owner":           "value3",
+				"team/platform/sub/owner":       "value4",
+				"key5":                          "value5",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyPatterns: []*regexp.Regexp{
+					regexp.MustCompile(`^aws:cloudformation:`),
+					regexp.MustCompile(`-managed-by$`),
+					regexp.MustCompile(`^team/.+/owner$`),
+				},
+			},
+			want: map[string]string{
+				"key5": "value5",
+			},
+		},
+		{
+			name: "keys, key prefixes, key globs and key patterns",
+			tags: New(ctx, map[string]string{
+				"key1":               "value1",
+				"prefix-key2":        "value2",
+				"billing-managed-by": "value3",
+				"team/a/owner":       "value4",
+				"key5":               "value5",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys:        New(ctx, []string{"key1"}),
+				KeyPrefixes: New(ctx, []string{"prefix-"}),
+				KeyGlobs:    []string{"*-managed-by"},
+				KeyPatterns: []*regexp.Regexp{regexp.MustCompile(`^team/`)},
+			},
+			want: map[string]string{
+				"key5": "value5",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -15,6 +15,7 @@ import (
 	tfawserr_sdkv2 "github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 )
 
 // Retryable is a function that is used to decide if a function's error is retryable or not.
@@ -382,12 +383,15 @@ func Retry(ctx context.Context, timeout time.Duration, f retry.RetryFunc, optFns
 		fn(&options)
 	}
 
+	ctx, span := tracing.Start(ctx, "Retry", tracing.SpanKindInternal)
+	defer span.End()
+
 	c := &retry.StateChangeConf{
 		Pending:    []string{"retryableerror"},
 		Target:     []string{"success"},
 		Timeout:    timeout,
 		MinTimeout: 500 * time.Millisecond,
-		Refresh: func() (interface{}, string, error) {
+		Refresh: tracedRefreshFunc(ctx, func() (interface{}, string, error) {
 			rerr := f()
 
 			resultErrMu.Lock()
@@ -405,7 +409,7 @@ func Retry(ctx context.Context, timeout time.Duration, f retry.RetryFunc, optFns
 			}
 
 			return nil, "quit", rerr.Err
-		},
+		}),
 	}
 
 	options.Apply(c)
@@ -420,10 +424,14 @@ func Retry(ctx context.Context, timeout time.Duration, f retry.RetryFunc, optFns
 	// resultErr may be nil because the wait timed out and resultErr was never
 	// set; this is still an error
 	if resultErr == nil {
+		if waitErr != nil {
+			span.SetError(waitErr.Error())
+		}
 		return waitErr
 	}
 	// resultErr takes precedence over waitErr if both are set because it is
 	// more likely to be useful
+	span.SetError(resultErr.Error())
 	return resultErr
 }
 
@@ -5,9 +5,11 @@ package tfresource
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 )
 
 type WaitOpts struct {
@@ -42,10 +44,13 @@ func WaitUntil(ctx context.Context, timeout time.Duration, f func() (bool, error
 		return "", targetStateFalse, nil
 	}
 
+	ctx, span := tracing.Start(ctx, "WaitUntil", tracing.SpanKindInternal)
+	defer span.End()
+
 	stateConf := &retry.StateChangeConf{
 		Pending:                   []string{targetStateFalse},
 		Target:                    []string{targetStateTrue},
-		Refresh:                   refresh,
+		Refresh:                   tracedRefreshFunc(ctx, refresh),
 		Timeout:                   timeout,
 		ContinuousTargetOccurence: opts.ContinuousTargetOccurence,
 		Delay:                     opts.Delay,
@@ -55,5 +60,57 @@ func WaitUntil(ctx context.Context, timeout time.Duration, f func() (bool, error
 
 	_, err := stateConf.WaitForStateContext(ctx)
 
+	if err != nil {
+		span.SetError(err.Error())
+	}
+
 	return err
 }
+
+// WaitForStateContext waits for the StateChangeConf's target state.
+// When tracing is enabled a span is recorded for the wait and for each poll, so waiters' time is visible in traces.
+// Waiters should call this function rather than calling StateChangeConf.WaitForStateContext directly.
+func WaitForStateContext(ctx context.Context, conf *retry.StateChangeConf) (interface{}, error) {
+	if !tracing.Enabled() {
+		return conf.WaitForStateContext(ctx)
+	}
+
+	ctx, span := tracing.Start(ctx, "WaitForState", tracing.SpanKindInternal, tracing.String("tf.wait.target", strings.Join(conf.Target, ",")))
+	defer span.End()
+
+	// Don't modify the caller's configuration.
+	c := *conf
+	c.Refresh = tracedRefreshFunc(ctx, conf.Refresh)
+
+	v, err := c.WaitForStateContext(ctx)
+
+	if err != nil {
+		span.SetError(err.Error())
+	}
+
+	return v, err
+}
+
+// tracedRefreshFunc returns a StateRefreshFunc that records a tracing span for each poll.
+// AWS API calls made by `f` are not children of the poll's span as `f` does not take a Context.
+func tracedRefreshFunc(ctx context.Context, f retry.StateRefreshFunc) retry.StateRefreshFunc {
+	if !tracing.Enabled() {
+		return f
+	}
+
+	var poll int
+	return func() (interface{}, string, error) {
+		poll++
+		_, span := tracing.Start(ctx, "poll", tracing.SpanKindInternal, tracing.Int("tf.poll", poll))
+		defer span.End()
+
+		v, state, err := f()
+
+		span.SetAttributes(tracing.String("tf.poll.state", state))
+		if err != nil {
+			span.SetError(err.Error())
+		}
+
+		return v, state, err
+	}
+}
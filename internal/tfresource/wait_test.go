@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -70,3 +71,35 @@ func TestWaitUntil(t *testing.T) { //nolint:tparallel
 		})
 	}
 }
+
+func TestWaitForStateContext(t *testing.T) {
+	ctx := acctest.Context(t)
+	t.Parallel()
+
+	var polls int32
+	conf := &retry.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"done"},
+		Refresh: func() (interface{}, string, error) {
+			if atomic.AddInt32(&polls, 1) < 2 {
+				return "", "pending", nil
+			}
+
+			return "result", "done", nil
+		},
+		Timeout:    5 * time.Second,
+		MinTimeout: 10 * time.Millisecond,
+	}
+
+	got, err := tfresource.WaitForStateContext(ctx, conf)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != "result" {
+		t.Errorf("got %v, want result", got)
+	}
+	if got, want := atomic.LoadInt32(&polls), int32(2); got != want {
+		t.Errorf("got %d polls, want %d", got, want)
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-aws/version"
+)
+
+const (
+	instrumentationScopeName = "github.com/hashicorp/terraform-provider-aws"
+	serviceName              = "terraform-provider-aws"
+)
+
+// OTLP JSON encoding of an ExportTraceServiceRequest.
+// See https://opentelemetry.io/docs/specs/otlp/#json-protobuf-encoding.
+// Trace and span IDs are hex-encoded and 64-bit integers are encoded as decimal strings.
+
+type otlpTracesData struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Status            otlpStatus     `json:"status"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+const (
+	otlpStatusCodeUnset = 0
+	otlpStatusCodeError = 2
+)
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	BoolValue   *bool   `json:"boolValue,omitempty"`
+	IntValue    *string `json:"intValue,omitempty"`
+}
+
+func newOTLPKeyValue(key string, value any) otlpKeyValue {
+	kv := otlpKeyValue{Key: key}
+
+	switch v := value.(type) {
+	case string:
+		kv.Value.StringValue = &v
+	case bool:
+		kv.Value.BoolValue = &v
+	case int:
+		s := strconv.FormatInt(int64(v), 10)
+		kv.Value.IntValue = &s
+	case int64:
+		s := strconv.FormatInt(v, 10)
+		kv.Value.IntValue = &s
+	}
+
+	return kv
+}
+
+// exporter writes each ended span as a single line of OTLP JSON.
+// Each line is a complete ExportTraceServiceRequest, the format read by the OpenTelemetry Collector's otlpjsonfile receiver.
+type exporter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func newExporter(w io.Writer) *exporter {
+	return &exporter{
+		w: w,
+	}
+}
+
+func (e *exporter) export(s *Span) {
+	s.mu.Lock()
+	span := otlpSpan{
+		TraceID:           s.traceID,
+		SpanID:            s.spanID,
+		ParentSpanID:      s.parentSpanID,
+		Name:              s.name,
+		Kind:              int(s.kind),
+		StartTimeUnixNano: strconv.FormatInt(s.start.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(s.end.UnixNano(), 10),
+		Status: otlpStatus{
+			Code: otlpStatusCodeUnset,
+		},
+	}
+	for _, v := range s.attributes {
+		span.Attributes = append(span.Attributes, newOTLPKeyValue(v.Key, v.Value))
+	}
+	if s.isError {
+		span.Status.Code = otlpStatusCodeError
+		span.Status.Message = s.errorMessage
+	}
+	s.mu.Unlock()
+
+	data := otlpTracesData{
+		ResourceSpans: []otlpResourceSpans{{
+			Resource: otlpResource{
+				Attributes: []otlpKeyValue{
+					newOTLPKeyValue("service.name", serviceName),
+					newOTLPKeyValue("service.version", version.ProviderVersion),
+				},
+			},
+			ScopeSpans: []otlpScopeSpans{{
+				Scope: otlpScope{
+					Name:    instrumentationScopeName,
+					Version: version.ProviderVersion,
+				},
+				Spans: []otlpSpan{span},
+			}},
+		}},
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		return
+	}
+	b = append(b, '\n')
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	// Tracing is best effort.
+	if _, err := e.w.Write(b); err != nil {
+		return
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tracing records spans for provider operations and writes them to a local file in OTLP JSON format.
+// Tracing is enabled by setting the TF_AWS_OTLP_TRACES_FILE environment variable to the file path.
+// The file can be loaded into tracing backends such as Jaeger or Grafana Tempo without a live collector.
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	// TracesFileEnvVar is the environment variable that enables tracing and sets the traces file path.
+	TracesFileEnvVar = "TF_AWS_OTLP_TRACES_FILE"
+)
+
+// SpanKind is the OTLP span kind.
+type SpanKind int
+
+const (
+	SpanKindInternal SpanKind = 1 // Internal operation, e.g. a CRUD handler.
+	SpanKindClient   SpanKind = 3 // Outgoing request, e.g. an AWS API call.
+)
+
+// Attribute is a span attribute.
+type Attribute struct {
+	Key   string
+	Value any // string, bool, int or int64.
+}
+
+func String(key, value string) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+func Int(key string, value int) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+func Bool(key string, value bool) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Span is an in-progress span.
+// All methods are safe to call on a nil Span, which is returned when tracing is disabled.
+type Span struct {
+	attributes   []Attribute
+	end          time.Time
+	errorMessage string
+	exporter     *exporter
+	isError      bool
+	kind         SpanKind
+	mu           sync.Mutex
+	name         string
+	parentSpanID string
+	spanID       string
+	start        time.Time
+	traceID      string
+}
+
+// Start starts a span, returning a Context containing the span.
+// The span is a child of any span in the specified Context.
+func Start(ctx context.Context, name string, kind SpanKind, attributes ...Attribute) (context.Context, *Span) {
+	return start(ctx, defaultExporter(), name, kind, attributes...)
+}
+
+func start(ctx context.Context, exporter *exporter, name string, kind SpanKind, attributes ...Attribute) (context.Context, *Span) {
+	if exporter == nil {
+		return ctx, nil
+	}
+
+	span := &Span{
+		attributes: attributes,
+		exporter:   exporter,
+		kind:       kind,
+		name:       name,
+		spanID:     newID(8),
+		start:      time.Now(),
+	}
+
+	if parent, ok := fromContext(ctx); ok {
+		span.parentSpanID = parent.spanID
+		span.traceID = parent.traceID
+	} else {
+		span.traceID = newID(16)
+	}
+
+	return context.WithValue(ctx, contextKey, span), span
+}
+
+// Enabled returns whether tracing is enabled.
+func Enabled() bool {
+	return defaultExporter() != nil
+}
+
+// SetAttributes adds attributes to the span.
+func (s *Span) SetAttributes(attributes ...Attribute) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.attributes = append(s.attributes, attributes...)
+}
+
+// SetError marks the span as failed.
+func (s *Span) SetError(message string) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.isError = true
+	s.errorMessage = message
+}
+
+// End ends the span and exports it.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	s.end = time.Now()
+	s.mu.Unlock()
+
+	s.exporter.export(s)
+}
+
+type contextKeyType int
+
+var contextKey contextKeyType
+
+func fromContext(ctx context.Context) (*Span, bool) {
+	v, ok := ctx.Value(contextKey).(*Span)
+	return v, ok && v != nil
+}
+
+// newID returns a random hex-encoded ID of the specified number of bytes.
+func newID(n int) string {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+
+	return hex.EncodeToString(b)
+}
+
+var (
+	defaultExporterOnce  sync.Once
+	defaultExporterValue *exporter
+)
+
+// defaultExporter returns the exporter configured by environment variable, or nil if tracing is disabled.
+func defaultExporter() *exporter {
+	defaultExporterOnce.Do(func() {
+		path := os.Getenv(TracesFileEnvVar)
+		if path == "" {
+			return
+		}
+
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return
+		}
+
+		defaultExporterValue = newExporter(f)
+	})
+
+	return defaultExporterValue
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+func TestStartDisabled(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+	got, span := start(ctx, nil, "test", SpanKindInternal)
+
+	if span != nil {
+		t.Errorf("expected nil span, got %v", span)
+	}
+	if got != ctx {
+		t.Error("expected unchanged Context")
+	}
+
+	// Methods are safe on a nil Span.
+	span.SetAttributes(String("key", "value"))
+	span.SetError("error")
+	span.End()
+}
+
+func TestExport(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	exporter := newExporter(&buf)
+
+	ctx, parent := start(context.TODO(), exporter, "aws_vpc Create", SpanKindInternal, String("tf.resource_type", "aws_vpc"))
+	_, child := start(ctx, exporter, "EC2.CreateVpc", SpanKindClient)
+	child.SetAttributes(Int("http.response.status_code", 400), Bool("retried", true))
+	child.SetError("InvalidParameterValue")
+	child.End()
+	parent.End()
+
+	var got []otlpTracesData
+	decoder := json.NewDecoder(&buf)
+	for decoder.More() {
+		var v otlpTracesData
+		if err := decoder.Decode(&v); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, v)
+	}
+
+	if got, want := len(got), 2; got != want {
+		t.Fatalf("got %d lines, want %d", got, want)
+	}
+
+	childSpan := got[0].ResourceSpans[0].ScopeSpans[0].Spans[0]
+	parentSpan := got[1].ResourceSpans[0].ScopeSpans[0].Spans[0]
+
+	if got, want := len(parentSpan.TraceID), 32; got != want {
+		t.Errorf("trace ID length: got %d, want %d", got, want)
+	}
+	if got, want := len(parentSpan.SpanID), 16; got != want {
+		t.Errorf("span ID length: got %d, want %d", got, want)
+	}
+	if parentSpan.ParentSpanID != "" {
+		t.Errorf("unexpected parent span ID: %s", parentSpan.ParentSpanID)
+	}
+	if got, want := childSpan.TraceID, parentSpan.TraceID; got != want {
+		t.Errorf("child trace ID: got %s, want %s", got, want)
+	}
+	if got, want := childSpan.ParentSpanID, parentSpan.SpanID; got != want {
+		t.Errorf("child parent span ID: got %s, want %s", got, want)
+	}
+	if got, want := childSpan.Kind, int(SpanKindClient); got != want {
+		t.Errorf("child kind: got %d, want %d", got, want)
+	}
+	if got, want := childSpan.Status.Code, otlpStatusCodeError; got != want {
+		t.Errorf("child status code: got %d, want %d", got, want)
+	}
+	if got, want := len(childSpan.Attributes), 2; got != want {
+		t.Fatalf("child attributes: got %d, want %d", got, want)
+	}
+	if v := childSpan.Attributes[0].Value.IntValue; v == nil || *v != "400" {
+		t.Errorf("unexpected int attribute value: %v", v)
+	}
+	if got, want := parentSpan.Status.Code, otlpStatusCodeUnset; got != want {
+		t.Errorf("parent status code: got %d, want %d", got, want)
+	}
+}
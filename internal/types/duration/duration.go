@@ -16,18 +16,40 @@ import (
 var ErrSyntax = errors.New("invalid syntax")
 
 const (
-	pattern = `^(?i)P((?P<years>\d+)Y)?((?P<months>\d+)M)?((?P<days>\d+)D)?$`
+	pattern         = `^(?i)P((?P<years>\d+)Y)?((?P<months>\d+)M)?((?P<days>\d+)D)?$`
+	iso8601Pattern  = `^(?i)P((?P<years>\d+)Y)?((?P<months>\d+)M)?((?P<weeks>\d+)W)?((?P<days>\d+)D)?(T((?P<hours>\d+)H)?((?P<minutes>\d+)M)?((?P<seconds>\d+)S)?)?$`
+	secondsPerDay   = 24 * 60 * 60
+	secondsPerMonth = 30 * secondsPerDay
+	secondsPerYear  = 365 * secondsPerDay
 )
 
 // Durations supports the year-month-day subset of an RFC 3339 duration
 // https://www.rfc-editor.org/rfc/rfc3339
+// Durations returned by ParseISO8601 may also have week, hour, minute and second components.
 type Duration struct {
-	years  int
-	months int
-	days   int
+	years   int
+	months  int
+	weeks   int
+	days    int
+	hours   int
+	minutes int
+	seconds int
 }
 
 func Parse(s string) (Duration, error) {
+	return parse(s, pattern)
+}
+
+// ParseISO8601 parses an ISO 8601 duration with integer components, e.g. "P1Y2M3DT4H5M6S" or "P2W".
+func ParseISO8601(s string) (Duration, error) {
+	if strings.HasSuffix(s, "T") || strings.HasSuffix(s, "t") {
+		return Duration{}, ErrSyntax
+	}
+
+	return parse(s, iso8601Pattern)
+}
+
+func parse(s, pattern string) (Duration, error) {
 	if s == "" || s == "P" {
 		return Duration{}, ErrSyntax
 	}
@@ -56,8 +78,16 @@ func Parse(s string) (Duration, error) {
 			duration.years = v
 		case "months":
 			duration.months = v
+		case "weeks":
+			duration.weeks = v
 		case "days":
 			duration.days = v
+		case "hours":
+			duration.hours = v
+		case "minutes":
+			duration.minutes = v
+		case "seconds":
+			duration.seconds = v
 		}
 	}
 
@@ -73,23 +103,75 @@ func (d Duration) String() string {
 	if d.months > 0 {
 		fmt.Fprintf(&b, "%dM", d.months)
 	}
+	if d.weeks > 0 {
+		fmt.Fprintf(&b, "%dW", d.weeks)
+	}
 	if d.days > 0 {
 		fmt.Fprintf(&b, "%dD", d.days)
 	}
+	if d.hours > 0 || d.minutes > 0 || d.seconds > 0 {
+		b.WriteString("T")
+	}
+	if d.hours > 0 {
+		fmt.Fprintf(&b, "%dH", d.hours)
+	}
+	if d.minutes > 0 {
+		fmt.Fprintf(&b, "%dM", d.minutes)
+	}
+	if d.seconds > 0 {
+		fmt.Fprintf(&b, "%dS", d.seconds)
+	}
 	return b.String()
 }
 
 func (d Duration) IsZero() bool {
-	return d.years == 0 && d.months == 0 && d.days == 0
+	return d == Duration{}
+}
+
+func (d Duration) Years() int {
+	return d.years
+}
+
+func (d Duration) Months() int {
+	return d.months
+}
+
+func (d Duration) Weeks() int {
+	return d.weeks
+}
+
+func (d Duration) Days() int {
+	return d.days
+}
+
+func (d Duration) Hours() int {
+	return d.hours
+}
+
+func (d Duration) Minutes() int {
+	return d.minutes
+}
+
+func (d Duration) Seconds() int {
+	return d.seconds
+}
+
+// TotalSeconds returns the length of the duration in seconds.
+// Years are counted as 365 days and months as 30 days.
+func (d Duration) TotalSeconds() int64 {
+	return int64(d.years)*secondsPerYear +
+		int64(d.months)*secondsPerMonth +
+		(int64(d.weeks)*7+int64(d.days))*secondsPerDay +
+		int64(d.hours)*60*60 +
+		int64(d.minutes)*60 +
+		int64(d.seconds)
 }
 
 func (d Duration) equal(o Duration) bool {
-	if d.years != o.years || d.months != o.months || d.days != o.days {
-		return false
-	}
-	return true
+	return d == o
 }
 
 func Sub(t time.Time, d Duration) time.Time {
-	return t.AddDate(-d.years, -d.months, -d.days)
+	t = t.AddDate(-d.years, -d.months, -(d.weeks*7 + d.days))
+	return t.Add(-(time.Duration(d.hours)*time.Hour + time.Duration(d.minutes)*time.Minute + time.Duration(d.seconds)*time.Second))
 }
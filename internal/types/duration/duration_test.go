@@ -97,6 +97,143 @@ func TestParse(t *testing.T) {
 	}
 }
 
+func TestParseISO8601(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		input       string
+		expected    Duration
+		expectedErr error
+	}{
+		// Invalid
+		"empty": {
+			input:       "",
+			expectedErr: ErrSyntax,
+		},
+		"P only": {
+			input:       "P",
+			expectedErr: ErrSyntax,
+		},
+		"T only": {
+			input:       "PT",
+			expectedErr: ErrSyntax,
+		},
+		"trailing T": {
+			input:       "P1DT",
+			expectedErr: ErrSyntax,
+		},
+		"time without T": {
+			input:       "P1H",
+			expectedErr: ErrSyntax,
+		},
+		"fractional": {
+			input:       "PT1.5S",
+			expectedErr: ErrSyntax,
+		},
+		"out of order": {
+			input:       "PT1S1M",
+			expectedErr: ErrSyntax,
+		},
+
+		// Date
+		"years months days": {
+			input:    "P2Y1M10D",
+			expected: Duration{years: 2, months: 1, days: 10},
+		},
+		"weeks": {
+			input:    "P2W",
+			expected: Duration{weeks: 2},
+		},
+
+		// Time
+		"hours": {
+			input:    "PT12H",
+			expected: Duration{hours: 12},
+		},
+		"minutes": {
+			input:    "PT30M",
+			expected: Duration{minutes: 30},
+		},
+		"seconds": {
+			input:    "PT90S",
+			expected: Duration{seconds: 90},
+		},
+
+		// Multiple
+		"all": {
+			input:    "P1Y2M3W4DT5H6M7S",
+			expected: Duration{years: 1, months: 2, weeks: 3, days: 4, hours: 5, minutes: 6, seconds: 7},
+		},
+		"insensitive": {
+			input:    "p1dt2h",
+			expected: Duration{days: 1, hours: 2},
+		},
+	}
+
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			duration, err := ParseISO8601(tc.input)
+
+			if tc.expectedErr == nil && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if tc.expectedErr != nil {
+				if !errors.Is(err, tc.expectedErr) {
+					t.Fatalf("expected error matching \"%s\", got %s", tc.expectedErr, err)
+				}
+			}
+
+			if !duration.equal(tc.expected) {
+				t.Errorf("expected %q, got %q", tc.expected, duration)
+			}
+		})
+	}
+}
+
+func TestTotalSeconds(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		duration Duration
+		expected int64
+	}{
+		"zero": {
+			duration: Duration{},
+			expected: 0,
+		},
+		"year": {
+			duration: Duration{years: 1},
+			expected: 365 * 24 * 60 * 60,
+		},
+		"month": {
+			duration: Duration{months: 1},
+			expected: 30 * 24 * 60 * 60,
+		},
+		"weeks days": {
+			duration: Duration{weeks: 1, days: 1},
+			expected: 8 * 24 * 60 * 60,
+		},
+		"time": {
+			duration: Duration{hours: 1, minutes: 2, seconds: 3},
+			expected: 3723,
+		},
+	}
+
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if actual := tc.duration.TotalSeconds(); actual != tc.expected {
+				t.Errorf("expected %d, got %d", tc.expected, actual)
+			}
+		})
+	}
+}
+
 func TestSub(t *testing.T) {
 	t.Parallel()
 
@@ -146,6 +283,12 @@ func TestSub(t *testing.T) {
 			expected:  time.Date(2022, 3, 11, 12, 0, 0, 0, tz),
 			hoursDiff: 3*24 - 1,
 		},
+		"daylight saving hours": {
+			startTime: time.Date(2022, 3, 14, 12, 0, 0, 0, tz),
+			duration:  Duration{hours: 72},
+			expected:  time.Date(2022, 3, 11, 11, 0, 0, 0, tz),
+			hoursDiff: 3 * 24,
+		},
 	}
 
 	for name, tc := range testcases {
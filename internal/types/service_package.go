@@ -17,11 +17,20 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourceRegion represents resource-level Region information.
+// Resources and data sources opt in to per-resource Region override with the `@Region(overrideEnabled=true)` annotation.
+// Opting in requires that the resource uses the effective Region, not the provider's configured Region, e.g. when constructing ARNs.
+// Plugin Framework resources' and data sources' models must also include the `region` attribute.
+type ServicePackageResourceRegion struct {
+	IsOverrideEnabled bool // Can the resource's Region be overridden?
+}
+
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
 // implemented by a service package.
 type ServicePackageFrameworkDataSource struct {
 	Factory func(context.Context) (datasource.DataSourceWithConfigure, error)
 	Name    string
+	Region  *ServicePackageResourceRegion
 	Tags    *ServicePackageResourceTags
 }
 
@@ -30,6 +39,7 @@ type ServicePackageFrameworkDataSource struct {
 type ServicePackageFrameworkResource struct {
 	Factory func(context.Context) (resource.ResourceWithConfigure, error)
 	Name    string
+	Region  *ServicePackageResourceRegion
 	Tags    *ServicePackageResourceTags
 }
 
@@ -39,6 +49,7 @@ type ServicePackageSDKDataSource struct {
 	Factory  func() *schema.Resource
 	TypeName string
 	Name     string
+	Region   *ServicePackageResourceRegion
 	Tags     *ServicePackageResourceTags
 }
 
@@ -48,5 +59,6 @@ type ServicePackageSDKResource struct {
 	Factory  func() *schema.Resource
 	TypeName string
 	Name     string
+	Region   *ServicePackageResourceRegion
 	Tags     *ServicePackageResourceTags
 }
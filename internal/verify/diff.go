@@ -22,7 +22,7 @@ import (
 // after resource READ operations as resource and provider-level tags
 // will be indistinguishable when returned from an AWS API.
 func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	defaultTagsConfig := meta.(*conns.AWSClient).ResourceDefaultTagsConfig(ctx)
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	resourceTags := tftags.New(ctx, diff.Get("tags").(map[string]interface{}))
@@ -42,6 +42,11 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 		return nil
 	}
 
+	// Enforce any provider configured required_tags.
+	if err := meta.(*conns.AWSClient).RequiredTagsConfig.Validate(defaultTagsConfig.MergeTags(resourceTags)); err != nil {
+		return err
+	}
+
 	if diff.HasChange("tags") {
 		_, n := diff.GetChange("tags")
 		newTags := tftags.New(ctx, n.(map[string]interface{}))
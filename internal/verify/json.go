@@ -15,6 +15,7 @@ import (
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
 	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 )
 
@@ -25,28 +26,7 @@ func SuppressEquivalentPolicyDiffs(k, old, new string, d *schema.ResourceData) b
 }
 
 func PolicyStringsEquivalent(s1, s2 string) bool {
-	if strings.TrimSpace(s1) == "" && strings.TrimSpace(s2) == "" {
-		return true
-	}
-
-	if strings.TrimSpace(s1) == "{}" && strings.TrimSpace(s2) == "" {
-		return true
-	}
-
-	if strings.TrimSpace(s1) == "" && strings.TrimSpace(s2) == "{}" {
-		return true
-	}
-
-	if strings.TrimSpace(s1) == "{}" && strings.TrimSpace(s2) == "{}" {
-		return true
-	}
-
-	equivalent, err := awspolicy.PoliciesAreEquivalent(s1, s2)
-	if err != nil {
-		return false
-	}
-
-	return equivalent
+	return iampolicy.StringsEquivalent(s1, s2)
 }
 
 // SuppressEquivalentJSONDiffs returns a difference suppression function that compares
@@ -176,25 +156,7 @@ func PolicyToSet(exist, new string) (string, error) {
 // Version not being first is one reason for this error:
 // MalformedPolicyDocument: The policy failed legacy parsing
 func LegacyPolicyNormalize(policy interface{}) (string, error) {
-	if policy == nil || policy.(string) == "" {
-		return "", nil
-	}
-
-	np, err := structure.NormalizeJsonString(policy)
-	if err != nil {
-		return policy.(string), fmt.Errorf("legacy policy (%s) is invalid JSON: %w", policy, err)
-	}
-
-	m := regexache.MustCompile(`(?s)^(\{\n?)(.*?)(,\s*)?(  )?("Version":\s*"2012-10-17")(,)?(\n)?(.*?)(\})`)
-
-	n := m.ReplaceAllString(np, `$1$4$5$3$2$6$7$8$9`)
-
-	_, err = structure.NormalizeJsonString(n)
-	if err != nil {
-		return policy.(string), fmt.Errorf("LegacyPolicyNormalize created a policy (%s) that is invalid JSON: %w", n, err)
-	}
-
-	return n, nil
+	return iampolicy.LegacyNormalize(policy)
 }
 
 // LegacyPolicyToSet returns the existing policy if the new policy is equivalent.
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"path"
 	"regexp"
 	"slices"
 	"strconv"
@@ -454,6 +455,21 @@ var ValidStringDateOrPositiveInt = validation.Any(
 	validation.StringMatch(regexache.MustCompile(`^\d+$`), "must be a positive integer value"),
 )
 
+// ValidGlobPattern validates a glob pattern, e.g. "aws_s3_*".
+// Patterns use path.Match syntax.
+func ValidGlobPattern(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, err := path.Match(value, ""); err != nil {
+		errors = append(errors, fmt.Errorf("%q (%q) is not a valid glob pattern: %s", k, value, err))
+	}
+	return
+}
+
 func ValidDuration(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	duration, err := time.ParseDuration(value)
@@ -482,6 +482,34 @@ func TestValidStringIsJSONOrYAML(t *testing.T) {
 	}
 }
 
+func TestValidGlobPattern(t *testing.T) {
+	t.Parallel()
+
+	validPatterns := []string{
+		"aws_instance",
+		"aws_s3_*",
+		"aws_?pc",
+		"aws_[a-z]*_bucket",
+	}
+	for _, v := range validPatterns {
+		_, errors := ValidGlobPattern(v, "include_resource_types")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid glob pattern: %q", v, errors)
+		}
+	}
+
+	invalidPatterns := []string{
+		"aws_[s3",
+		`aws_s3_\`,
+	}
+	for _, v := range invalidPatterns {
+		_, errors := ValidGlobPattern(v, "include_resource_types")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid glob pattern", v)
+		}
+	}
+}
+
 func TestValidOnceAWeekWindowFormat(t *testing.T) {
 	t.Parallel()
 
@@ -4,11 +4,32 @@
 package verify
 
 import (
+	"strings"
+
 	"gopkg.in/yaml.v2"
 )
 
 const UUIDRegexPattern = `[0-9a-f]{8}-[0-9a-f]{4}-[1-5][0-9a-f]{3}-[ab89][0-9a-f]{3}-[0-9a-f]{12}`
 
+// RegionalImportIDSeparator separates a resource's import ID from an AWS Region, e.g. `vpc-0123456789abcdef0@eu-west-1`.
+const RegionalImportIDSeparator = "@"
+
+// ParseRegionalImportID splits an import ID of the form `<id>@<region>` into its constituent parts.
+// Returns false if the import ID does not end in a valid AWS Region.
+func ParseRegionalImportID(importID string) (string, string, bool) {
+	i := strings.LastIndex(importID, RegionalImportIDSeparator)
+	if i < 1 {
+		return "", "", false
+	}
+
+	id, region := importID[:i], importID[i+len(RegionalImportIDSeparator):]
+	if !regionRegexp.MatchString(region) {
+		return "", "", false
+	}
+
+	return id, region, true
+}
+
 // Takes a value containing YAML string and passes it through
 // the YAML parser. Returns either a parsing
 // error or original YAML string.
@@ -44,3 +44,58 @@ abc:
 		t.Fatalf("Got:\n\n%s\n\nExpected:\n\n%s\n", actual, invalidYaml)
 	}
 }
+
+func TestParseRegionalImportID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		importID   string
+		wantID     string
+		wantRegion string
+		wantOK     bool
+	}{
+		{
+			importID: "",
+		},
+		{
+			importID: "vpc-0123456789abcdef0",
+		},
+		{
+			importID:   "vpc-0123456789abcdef0@eu-west-1", //lintignore:AWSAT003
+			wantID:     "vpc-0123456789abcdef0",
+			wantRegion: "eu-west-1", //lintignore:AWSAT003
+			wantOK:     true,
+		},
+		{
+			importID:   "user@example.com@us-gov-west-1", //lintignore:AWSAT003
+			wantID:     "user@example.com",
+			wantRegion: "us-gov-west-1", //lintignore:AWSAT003
+			wantOK:     true,
+		},
+		{
+			importID: "user@example.com",
+		},
+		{
+			importID: "@eu-west-1", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.importID, func(t *testing.T) {
+			t.Parallel()
+
+			gotID, gotRegion, gotOK := ParseRegionalImportID(testCase.importID)
+
+			if gotOK != testCase.wantOK {
+				t.Errorf("ParseRegionalImportID(%q) ok = %v, want %v", testCase.importID, gotOK, testCase.wantOK)
+			}
+			if gotID != testCase.wantID {
+				t.Errorf("ParseRegionalImportID(%q) id = %q, want %q", testCase.importID, gotID, testCase.wantID)
+			}
+			if gotRegion != testCase.wantRegion {
+				t.Errorf("ParseRegionalImportID(%q) region = %q, want %q", testCase.importID, gotRegion, testCase.wantRegion)
+			}
+		})
+	}
+}
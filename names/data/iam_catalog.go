@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package data
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// IAMCatalog is a catalog of the IAM actions, resource types and condition keys of AWS services.
+// iam_catalog.json is generated from the AWS Service Authorization Reference by `make gen-iam-catalog`.
+type IAMCatalog struct {
+	// Version is the date that the catalog was generated.
+	Version             string              `json:"version"`
+	GlobalConditionKeys []string            `json:"global_condition_keys"`
+	Services            []IAMCatalogService `json:"services"`
+
+	services map[string]*IAMCatalogService
+}
+
+type IAMCatalogService struct {
+	Prefix string `json:"prefix"`
+	// Version is the Service Authorization Reference version of the service.
+	Version       string                   `json:"version"`
+	Actions       []string                 `json:"actions"`
+	ResourceTypes []IAMCatalogResourceType `json:"resource_types"`
+	ConditionKeys []string                 `json:"condition_keys"`
+}
+
+type IAMCatalogResourceType struct {
+	Name       string   `json:"name"`
+	ARNFormats []string `json:"arn_formats"`
+}
+
+//go:embed iam_catalog.json
+var iamCatalog []byte
+
+var readIAMCatalog = sync.OnceValues(func() (*IAMCatalog, error) {
+	var c IAMCatalog
+	if err := json.Unmarshal(iamCatalog, &c); err != nil {
+		return nil, fmt.Errorf("decoding IAM catalog: %w", err)
+	}
+
+	c.services = make(map[string]*IAMCatalogService, len(c.Services))
+	for i := range c.Services {
+		c.services[strings.ToLower(c.Services[i].Prefix)] = &c.Services[i]
+	}
+
+	return &c, nil
+})
+
+// ReadIAMCatalog returns the embedded IAM catalog. The catalog is shared and must not be modified.
+func ReadIAMCatalog() (*IAMCatalog, error) {
+	return readIAMCatalog()
+}
+
+// Service returns the catalog entry for the service with the specified prefix, e.g. "s3".
+func (c *IAMCatalog) Service(prefix string) (*IAMCatalogService, bool) {
+	v, ok := c.services[strings.ToLower(prefix)]
+	return v, ok
+}
+
+// CheckAction returns an error if an IAM action, which may contain wildcards, matches no action in the catalog.
+// Actions of services that aren't in the catalog aren't checked, as the catalog may lag behind new services.
+func (c *IAMCatalog) CheckAction(action string) error {
+	prefix, name, ok := strings.Cut(action, ":")
+	if !ok {
+		return nil
+	}
+
+	service, ok := c.Service(prefix)
+	if !ok {
+		return nil
+	}
+
+	if strings.ContainsAny(name, "*?") {
+		pattern := strings.ToLower(name)
+		if slices.ContainsFunc(service.Actions, func(v string) bool {
+			ok, _ := path.Match(pattern, strings.ToLower(v))
+			return ok
+		}) {
+			return nil
+		}
+
+		return fmt.Errorf("IAM action (%s) matches no %s actions in the IAM catalog (version %s)", action, service.Prefix, c.Version)
+	}
+
+	if slices.ContainsFunc(service.Actions, func(v string) bool { return strings.EqualFold(v, name) }) {
+		return nil
+	}
+
+	suggestions := closestMatches(name, service.Actions, func(v string) string { return v })
+	for i, v := range suggestions {
+		suggestions[i] = service.Prefix + ":" + v
+	}
+
+	return notInCatalogError("IAM action", action, c.Version, suggestions)
+}
+
+// CheckConditionKey returns an error if an IAM condition key isn't in the catalog.
+// Global condition keys are prefixed with "aws:". Condition keys of services that aren't in the catalog aren't checked.
+func (c *IAMCatalog) CheckConditionKey(key string) error {
+	prefix, _, ok := strings.Cut(key, ":")
+	if !ok {
+		return nil
+	}
+
+	var keys []string
+	if strings.EqualFold(prefix, "aws") {
+		keys = c.GlobalConditionKeys
+	} else if service, ok := c.Service(prefix); ok {
+		keys = service.ConditionKeys
+	} else {
+		return nil
+	}
+
+	if slices.ContainsFunc(keys, func(v string) bool { return conditionKeyMatches(v, key) }) {
+		return nil
+	}
+
+	// Fill in templated keys, e.g. "aws:ResourceTag/${TagKey}", with the key's tag key, so that the suggestion is complete.
+	render := func(v string) string {
+		if template, _, ok := strings.Cut(v, "${"); ok {
+			if _, tagKey, ok := strings.Cut(key, "/"); ok && strings.HasSuffix(template, "/") {
+				return template + tagKey
+			}
+		}
+		return v
+	}
+
+	return notInCatalogError("IAM condition key", key, c.Version, closestMatches(key, keys, render))
+}
+
+// conditionKeyMatches returns whether a condition key matches a catalog condition key, which may end in a template
+// such as "${TagKey}". Condition keys are case-insensitive.
+func conditionKeyMatches(catalogKey, key string) bool {
+	if template, _, ok := strings.Cut(catalogKey, "${"); ok {
+		return len(key) > len(template) && strings.EqualFold(key[:len(template)], template)
+	}
+
+	return strings.EqualFold(catalogKey, key)
+}
+
+func notInCatalogError(kind, value, version string, suggestions []string) error {
+	if len(suggestions) == 0 {
+		return fmt.Errorf("%s (%s) is not in the IAM catalog (version %s)", kind, value, version)
+	}
+
+	return fmt.Errorf("%s (%s) is not in the IAM catalog (version %s). Did you mean %s?", kind, value, version, strings.Join(suggestions, " or "))
+}
+
+// closestMatches returns up to 3 of the candidates that are closest to s, ignoring case.
+// Only the candidates at the smallest distance are returned.
+// Candidates are rendered before comparison and returned rendered.
+func closestMatches(s string, candidates []string, render func(string) string) []string {
+	const (
+		maxSuggestions = 3
+	)
+
+	type match struct {
+		value    string
+		distance int
+	}
+
+	s = strings.ToLower(s)
+	// Allow roughly one edit for every three characters.
+	maxDistance := max(2, len(s)/3)
+
+	var matches []match
+	for _, v := range candidates {
+		v = render(v)
+		if d := levenshteinDistance(s, strings.ToLower(v)); d <= maxDistance {
+			matches = append(matches, match{value: v, distance: d})
+		}
+	}
+
+	slices.SortStableFunc(matches, func(a, b match) int {
+		if a.distance != b.distance {
+			return a.distance - b.distance
+		}
+		return strings.Compare(a.value, b.value)
+	})
+
+	var suggestions []string
+	for _, v := range matches {
+		if v.distance > matches[0].distance || len(suggestions) == maxSuggestions {
+			break
+		}
+		suggestions = append(suggestions, v.value)
+	}
+
+	return suggestions
+}
+
+// levenshteinDistance returns the number of single-character insertions, deletions and substitutions
+// needed to turn a into b.
+func levenshteinDistance(a, b string) int {
+	r1, r2 := []rune(a), []rune(b)
+
+	row := make([]int, len(r2)+1)
+	for j := range row {
+		row[j] = j
+	}
+
+	for i := 1; i <= len(r1); i++ {
+		prev := row[0]
+		row[0] = i
+
+		for j := 1; j <= len(r2); j++ {
+			cost := 1
+			if r1[i-1] == r2[j-1] {
+				cost = 0
+			}
+
+			prev, row[j] = row[j], min(row[j]+1, row[j-1]+1, prev+cost)
+		}
+	}
+
+	return row[len(r2)]
+}
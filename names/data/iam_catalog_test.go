@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package data
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIAMCatalogCheckAction(t *testing.T) {
+	t.Parallel()
+
+	catalog, err := ReadIAMCatalog()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		action      string
+		expectError bool
+		suggestion  string
+	}{
+		"known": {
+			action: "sts:AssumeRole",
+		},
+		"known case-insensitive": {
+			action: "SQS:sendmessage",
+		},
+		"misspelled": {
+			action:      "sqs:SendMessages",
+			expectError: true,
+			suggestion:  "sqs:SendMessage",
+		},
+		"wildcard": {
+			action: "sqs:send*",
+		},
+		"all actions": {
+			action: "sqs:*",
+		},
+		"wildcard no match": {
+			action:      "sqs:Foo*",
+			expectError: true,
+		},
+		"unknown service": {
+			action: "nosuchservice:Anything",
+		},
+		"no prefix": {
+			action: "*",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := catalog.CheckAction(testCase.action)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("CheckAction(%q) error = %v, expectError = %t", testCase.action, err, want)
+			}
+			if testCase.suggestion != "" && !strings.Contains(err.Error(), "Did you mean "+testCase.suggestion) {
+				t.Errorf("CheckAction(%q) error = %q, want suggestion %q", testCase.action, err, testCase.suggestion)
+			}
+		})
+	}
+}
+
+func TestIAMCatalogCheckActionSuggestions(t *testing.T) {
+	t.Parallel()
+
+	catalog, err := ReadIAMCatalog()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		action     string
+		suggestion string
+	}{
+		"s3": {
+			action:     "s3:GetObjects",
+			suggestion: "s3:GetObject",
+		},
+		"ec2": {
+			action:     "ec2:DescribeInstance",
+			suggestion: "ec2:DescribeInstances",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			prefix, _, _ := strings.Cut(testCase.action, ":")
+			if _, ok := catalog.Service(prefix); !ok {
+				t.Skipf("IAM catalog (version %s) does not include service %q, run 'make gen-iam-catalog'", catalog.Version, prefix)
+			}
+
+			err := catalog.CheckAction(testCase.action)
+
+			if err == nil {
+				t.Fatalf("CheckAction(%q) error = nil, want error", testCase.action)
+			}
+			if !strings.Contains(err.Error(), "Did you mean "+testCase.suggestion) {
+				t.Errorf("CheckAction(%q) error = %q, want suggestion %q", testCase.action, err, testCase.suggestion)
+			}
+		})
+	}
+}
+
+func TestIAMCatalogCheckConditionKey(t *testing.T) {
+	t.Parallel()
+
+	catalog, err := ReadIAMCatalog()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		key         string
+		expectError bool
+		suggestion  string
+	}{
+		"global": {
+			key: "aws:SourceIp",
+		},
+		"global case-insensitive": {
+			key: "aws:SourceIP",
+		},
+		"global template": {
+			key: "aws:PrincipalTag/team",
+		},
+		"global template without tag key": {
+			key:         "aws:PrincipalTag/",
+			expectError: true,
+		},
+		"global misspelled template": {
+			key:         "aws:PrincipleTag/team",
+			expectError: true,
+			suggestion:  "aws:PrincipalTag/team",
+		},
+		"service": {
+			key: "sts:ExternalID",
+		},
+		"service misspelled": {
+			key:         "sts:ExternalIdd",
+			expectError: true,
+			suggestion:  "sts:ExternalId",
+		},
+		"unknown service": {
+			key: "nosuchservice:Anything",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := catalog.CheckConditionKey(testCase.key)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("CheckConditionKey(%q) error = %v, expectError = %t", testCase.key, err, want)
+			}
+			if testCase.suggestion != "" && !strings.Contains(err.Error(), "Did you mean "+testCase.suggestion) {
+				t.Errorf("CheckConditionKey(%q) error = %q, want suggestion %q", testCase.key, err, testCase.suggestion)
+			}
+		})
+	}
+}
+
+func TestLevenshteinDistance(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"", "abc", 3},
+		{"kitten", "sitting", 3},
+		{"sendmessage", "sendmessages", 1},
+		{"flaw", "lawn", 2},
+		{"héllo", "hello", 1},
+	}
+
+	for _, testCase := range testCases {
+		if got := levenshteinDistance(testCase.a, testCase.b); got != testCase.want {
+			t.Errorf("levenshteinDistance(%q, %q) = %d, want %d", testCase.a, testCase.b, got, testCase.want)
+		}
+	}
+}
@@ -201,6 +201,12 @@ const (
 	EUISOEWest1RegionID = "eu-isoe-west-1" // EU ISOE West.
 )
 
+const (
+	// EC2UserDataMaxLength is the maximum length in bytes of EC2 instance user data, before base64 encoding.
+	// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/instancedata-add-user-data.html
+	EC2UserDataMaxLength = 16384
+)
+
 var allRegionIDs = []string{
 	AFSouth1RegionID,
 	APEast1RegionID,